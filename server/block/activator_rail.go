@@ -0,0 +1,86 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// ActivatorRail is a rail that activates minecarts riding over it while it is powered by redstone. TNT minecarts are
+// primed, hopper minecarts are locked and other minecarts eject their riders. An activator rail passes its power on
+// to up to 8 activator rails connected to it.
+type ActivatorRail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail. Activator rails cannot be curved.
+	Shape RailShape
+	// Powered is true if the rail is currently powered by redstone.
+	Powered bool
+}
+
+// RailShape returns the shape of the rail.
+func (r ActivatorRail) RailShape() RailShape {
+	return r.Shape
+}
+
+// withRailShape ...
+func (r ActivatorRail) withRailShape(s RailShape) world.Block {
+	r.Shape = s
+	return r
+}
+
+// MinecartRail ...
+func (ActivatorRail) MinecartRail() {}
+
+// curvable ...
+func (ActivatorRail) curvable() bool {
+	return false
+}
+
+// UseOnBlock ...
+func (r ActivatorRail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeRail(r, pos, face, w, user, ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r ActivatorRail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !railSupported(pos, w) {
+		breakUnsupportedRail(r, pos, w)
+		return
+	}
+	if powered := railPowered(pos, r, w); powered != r.Powered {
+		r.Powered = powered
+		w.SetBlock(pos, r, nil)
+	}
+}
+
+// HasLiquidDrops ...
+func (ActivatorRail) HasLiquidDrops() bool {
+	return true
+}
+
+// BreakInfo ...
+func (r ActivatorRail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(ActivatorRail{}))
+}
+
+// EncodeItem ...
+func (ActivatorRail) EncodeItem() (name string, meta int16) {
+	return "minecraft:activator_rail", 0
+}
+
+// EncodeBlock ...
+func (r ActivatorRail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:activator_rail", map[string]any{"rail_direction": int32(r.Shape.Uint8()), "rail_data_bit": boolByte(r.Powered)}
+}
+
+// allActivatorRails ...
+func allActivatorRails() (rails []world.Block) {
+	for _, s := range StraightRailShapes() {
+		rails = append(rails, ActivatorRail{Shape: s})
+		rails = append(rails, ActivatorRail{Shape: s, Powered: true})
+	}
+	return
+}
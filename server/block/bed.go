@@ -0,0 +1,203 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+)
+
+// Bed is a block that allows players to sleep through the night and to set their spawn point. A bed consists of
+// two parts: the foot and the head of the bed.
+type Bed struct {
+	transparent
+
+	// Colour is the colour of the bed.
+	Colour item.Colour
+	// Facing is the direction that the bed is facing. The head of the bed is on this side of the foot.
+	Facing cube.Direction
+	// Head is true if the block is the head part of the bed.
+	Head bool
+	// Occupied is true if a player is currently sleeping in the bed.
+	Occupied bool
+}
+
+// Sleeper represents an entity that is able to sleep in a Bed.
+type Sleeper interface {
+	world.Entity
+	// UUID returns the UUID of the Sleeper. It is used to set the spawn point of the Sleeper.
+	UUID() uuid.UUID
+	// Message sends a message to the Sleeper.
+	Message(a ...any)
+	// Sleep makes the Sleeper start sleeping in the bed with its head at the position passed.
+	Sleep(pos cube.Pos)
+	// Wake makes the Sleeper stop sleeping.
+	Wake()
+	// Sleeping returns the position of the head of the bed that the Sleeper is sleeping in, and true if it is
+	// currently sleeping.
+	Sleeping() (cube.Pos, bool)
+}
+
+// MaxCount always returns 1.
+func (Bed) MaxCount() int {
+	return 1
+}
+
+// Model ...
+func (Bed) Model() world.BlockModel {
+	return model.Bed{}
+}
+
+// SideClosed ...
+func (Bed) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (b Bed) BreakInfo() BreakInfo {
+	return newBreakInfo(0.2, alwaysHarvestable, nothingEffective, oneOf(Bed{Colour: b.Colour}))
+}
+
+// FlammabilityInfo ...
+func (Bed) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(0, 0, true)
+}
+
+// UseOnBlock places the bed, with its foot at the position clicked and its head in the direction that the user is
+// facing.
+func (b Bed) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	b.Facing = user.Rotation().Direction()
+	headPos := pos.Side(b.Facing.Face())
+	if !replaceableWith(w, headPos, b) || !supportsBed(w, pos) || !supportsBed(w, headPos) {
+		return false
+	}
+
+	place(w, pos, b, user, ctx)
+	if !placed(ctx) {
+		return false
+	}
+	b.Head = true
+	w.SetBlock(headPos, b, nil)
+	return true
+}
+
+// NeighbourUpdateTick removes the bed if its other half was removed.
+func (b Bed) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if _, ok := b.otherHalf(pos, w); !ok {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	}
+}
+
+// Activate makes the user sleep in the bed and sets its spawn point to the bed. In dimensions other than the
+// overworld, the bed explodes instead.
+func (b Bed) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	s, ok := u.(Sleeper)
+	if !ok {
+		return false
+	}
+	otherPos, ok := b.otherHalf(pos, w)
+	if !ok {
+		return false
+	}
+	headPos := pos
+	if !b.Head {
+		headPos = otherPos
+	}
+	if w.Dimension() != world.Overworld {
+		w.SetBlock(pos, nil, nil)
+		w.SetBlock(otherPos, nil, nil)
+		ExplosionConfig{Size: 5, SpawnFire: true}.Explode(w, headPos.Vec3Centre())
+		return true
+	}
+	if _, sleeping := s.Sleeping(); sleeping {
+		return false
+	}
+	if s.Position().Sub(headPos.Vec3Centre()).Len() > 3 && s.Position().Sub(otherPos.Vec3Centre()).Len() > 3 {
+		s.Message("You may not rest now; the bed is too far away")
+		return true
+	}
+	if w.PlayerSpawn(s.UUID()) != headPos {
+		w.SetPlayerSpawn(s.UUID(), headPos)
+		s.Message("Respawn point set")
+	}
+	if !canSleep(w, headPos) {
+		s.Message("You can only sleep at night and during thunderstorms")
+		return true
+	}
+	if head, ok := w.Block(headPos).(Bed); ok && head.Occupied {
+		s.Message("This bed is occupied")
+		return true
+	}
+	s.Sleep(headPos)
+	return true
+}
+
+// otherHalf returns the position of the other half of the bed. If the other half of the bed does not exist, false
+// is returned.
+func (b Bed) otherHalf(pos cube.Pos, w *world.World) (cube.Pos, bool) {
+	face := b.Facing.Face()
+	if b.Head {
+		face = face.Opposite()
+	}
+	otherPos := pos.Side(face)
+	other, ok := w.Block(otherPos).(Bed)
+	return otherPos, ok && other.Head != b.Head && other.Facing == b.Facing
+}
+
+// EncodeItem ...
+func (b Bed) EncodeItem() (name string, meta int16) {
+	return "minecraft:bed", int16(b.Colour.Uint8())
+}
+
+// EncodeBlock ...
+func (b Bed) EncodeBlock() (name string, properties map[string]any) {
+	return "minecraft:bed", map[string]any{
+		"direction":      int32(horizontalDirection(b.Facing)),
+		"head_piece_bit": b.Head,
+		"occupied_bit":   b.Occupied,
+	}
+}
+
+// EncodeNBT ...
+func (b Bed) EncodeNBT() map[string]any {
+	return map[string]any{"id": "Bed", "color": b.Colour.Uint8()}
+}
+
+// DecodeNBT ...
+func (b Bed) DecodeNBT(m map[string]any) any {
+	b.Colour = item.Colours()[nbtconv.Uint8(m, "color")%16]
+	return b
+}
+
+// supportsBed checks if the block at the position passed is able to hold up a bed.
+func supportsBed(w *world.World, pos cube.Pos) bool {
+	below := pos.Side(cube.FaceDown)
+	return w.Block(below).Model().FaceSolid(below, cube.FaceUp, w)
+}
+
+// canSleep checks if it is currently possible to sleep in a bed at the position passed. Sleeping is possible at
+// night and during thunderstorms.
+func canSleep(w *world.World, pos cube.Pos) bool {
+	t := w.Time() % 24000
+	return (t >= 12542 && t <= 23459) || w.ThunderingAt(pos)
+}
+
+// allBeds returns all possible states of a bed.
+func allBeds() (beds []world.Block) {
+	for _, d := range cube.Directions() {
+		for _, occupied := range []bool{false, true} {
+			beds = append(beds, Bed{Facing: d, Occupied: occupied})
+			beds = append(beds, Bed{Facing: d, Occupied: occupied, Head: true})
+		}
+	}
+	return
+}
@@ -74,6 +74,13 @@ type EntityInsider interface {
 	EntityInside(pos cube.Pos, w *world.World, e world.Entity)
 }
 
+// ProjectileHitter represents a block that reacts to being hit by a projectile, such as an arrow or a snowball.
+type ProjectileHitter interface {
+	// ProjectileHit is called when a projectile e hits the block at the position passed. face is the face of the
+	// block that was hit and hitPos is the exact position at which the projectile hit the block.
+	ProjectileHit(pos cube.Pos, face cube.Face, hitPos mgl64.Vec3, w *world.World, e world.Entity)
+}
+
 // Frictional represents a block that may have a custom friction value. Friction is used for entity drag when the
 // entity is on ground. If a block does not implement this interface, it should be assumed that its friction is 0.6.
 type Frictional interface {
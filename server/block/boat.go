@@ -0,0 +1,74 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Boat is an item that may be placed on water or on land to create a boat that players are able to ride in. Unlike
+// most items, Boat is located in the block package, as every boat is made out of a WoodType.
+type Boat struct {
+	// Wood is the type of wood of the boat. Crimson and warped wood boats do not exist.
+	Wood WoodType
+	// Chest is true if the boat carries a chest, which is able to hold 27 stacks of items.
+	Chest bool
+}
+
+// MaxCount always returns 1.
+func (Boat) MaxCount() int {
+	return 1
+}
+
+// Use places the boat on the water or block that the user is looking at.
+func (b Boat) Use(w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, dir := user.Position(), user.Rotation().Vec3()
+	if eyed, ok := user.(interface{ EyeHeight() float64 }); ok {
+		pos[1] += eyed.EyeHeight()
+	}
+	// Move along the line of sight of the user in small steps until water or a block is hit.
+	for i := 0; i < 50; i++ {
+		pos = pos.Add(dir.Mul(0.1))
+		bpos := cube.PosFromVec3(pos)
+		if l, ok := w.Liquid(bpos); ok {
+			if _, water := l.(Water); water {
+				b.place(mgl64.Vec3{pos[0], float64(bpos[1]) + 1, pos[2]}, w, user, ctx)
+				return true
+			}
+		}
+		if _, air := w.Block(bpos).(Air); !air {
+			return false
+		}
+	}
+	return false
+}
+
+// UseOnBlock places the boat on the side of the block clicked.
+func (b Boat) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	side := pos.Side(face)
+	if _, air := w.Block(side).(Air); !air {
+		return false
+	}
+	b.place(side.Vec3Middle().Sub(mgl64.Vec3{0, 0.5}), w, user, ctx)
+	return true
+}
+
+// place spawns the boat at the position passed, facing the same way as the user.
+func (b Boat) place(pos mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) {
+	w.AddEntity(w.EntityRegistry().Config().Boat(pos, user.Rotation().Yaw(), b.Wood, b.Chest))
+	ctx.SubtractFromCount(1)
+}
+
+// EncodeItem ...
+func (b Boat) EncodeItem() (name string, meta int16) {
+	if b.Chest {
+		return "minecraft:" + b.Wood.String() + "_chest_boat", 0
+	}
+	return "minecraft:" + b.Wood.String() + "_boat", 0
+}
+
+// BoatWoodTypes returns all wood types that boats may be made out of.
+func BoatWoodTypes() []WoodType {
+	return []WoodType{OakWood(), SpruceWood(), BirchWood(), JungleWood(), AcaciaWood(), DarkOakWood(), Mangrove(), Cherry()}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/item/loot"
 	"github.com/df-mc/dragonfly/server/world"
 	"math"
 	"math/rand"
@@ -17,6 +18,23 @@ type Breakable interface {
 	BreakInfo() BreakInfo
 }
 
+// BreakDrops returns the drops of the Breakable block passed when broken using the tool and enchantments
+// passed. If a loot table is registered for the block, the drops are generated using it. Otherwise, the drops
+// returned by the BreakInfo of the block are used.
+func BreakDrops(b Breakable, t item.Tool, enchantments []item.Enchantment) []item.Stack {
+	if bl, ok := b.(world.Block); ok {
+		name, _ := bl.EncodeBlock()
+		if table, ok := loot.Lookup(loot.BlockTable(name)); ok {
+			var tool item.Stack
+			if it, ok := t.(world.Item); ok {
+				tool = item.NewStack(it, 1).WithEnchantments(enchantments...)
+			}
+			return table.Generate(loot.Context{Tool: tool})
+		}
+	}
+	return b.BreakInfo().Drops(t, enchantments)
+}
+
 // BreakDuration returns the base duration that breaking the block passed takes when being broken using the
 // item passed.
 func BreakDuration(b world.Block, i item.Stack) time.Duration {
@@ -227,6 +245,47 @@ func silkTouchDrop(normal, silkTouch item.Stack) func(item.Tool, []item.Enchantm
 	}
 }
 
+// fortuneLevel returns the level of the fortune enchantment in the enchantments passed, or 0 if it is not present.
+func fortuneLevel(enchantments []item.Enchantment) int {
+	for _, enchant := range enchantments {
+		if _, ok := enchant.Type().(enchantment.Fortune); ok {
+			return enchant.Level()
+		}
+	}
+	return 0
+}
+
+// fortuneOreDrop returns a drop function that returns 1x of the silk touch drop when silk touch exists, or the normal
+// drop with its count multiplied depending on the level of fortune when it does not.
+func fortuneOreDrop(normal item.Stack, silkTouch world.Item) func(item.Tool, []item.Enchantment) []item.Stack {
+	return func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if hasSilkTouch(enchantments) {
+			return []item.Stack{item.NewStack(silkTouch, 1)}
+		}
+		drop := normal
+		if level := fortuneLevel(enchantments); level > 0 {
+			drop = normal.Grow(normal.Count() * ((enchantment.Fortune{}).DropMultiplier(level) - 1))
+		}
+		return []item.Stack{drop}
+	}
+}
+
+// fortuneBonusDrop returns a drop function that returns 1x of the silk touch drop when silk touch exists, or the
+// normal drop with a bonus added to its count depending on the level of fortune when it does not. The count of the
+// drop never exceeds max.
+func fortuneBonusDrop(normal item.Stack, silkTouch world.Item, max int) func(item.Tool, []item.Enchantment) []item.Stack {
+	return func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if hasSilkTouch(enchantments) {
+			return []item.Stack{item.NewStack(silkTouch, 1)}
+		}
+		drop := normal
+		if level := fortuneLevel(enchantments); level > 0 {
+			drop = normal.Grow(min(normal.Count()+(enchantment.Fortune{}).DropBonus(level), max) - normal.Count())
+		}
+		return []item.Stack{drop}
+	}
+}
+
 // silkTouchOnlyDrop returns a drop function that returns the drop when silk touch exists.
 func silkTouchOnlyDrop(it world.Item) func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
 	return func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
@@ -0,0 +1,198 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"sync"
+	"time"
+)
+
+const (
+	// brewingDuration is the time it takes for a brewing stand to brew a potion.
+	brewingDuration = time.Second * 20
+	// brewingFuel is the number of potions that may be brewed using a single blaze powder.
+	brewingFuel = 20
+)
+
+// BrewingRecipes looks up the potions brewed by brewing stands. The recipes registered in the recipe package are
+// registered as the BrewingRecipes of brewing stands when that package is initialised.
+type BrewingRecipes interface {
+	// Brew returns the item.Stack that the potion passed turns into when it is brewed with the reagent passed on the
+	// block passed, such as "brewing_stand". False is returned if the potion cannot be brewed with the reagent.
+	Brew(input, reagent item.Stack, block string) (item.Stack, bool)
+	// BrewingReagent checks if the item.Stack passed is used as a reagent to brew potions on the block passed.
+	BrewingReagent(reagent item.Stack, block string) bool
+}
+
+// brewingRecipes holds the BrewingRecipes registered using RegisterBrewingRecipes.
+var brewingRecipes BrewingRecipes = noBrewingRecipes{}
+
+// RegisterBrewingRecipes registers the BrewingRecipes used by brewing stands to brew potions, replacing the
+// BrewingRecipes registered previously.
+func RegisterBrewingRecipes(r BrewingRecipes) {
+	brewingRecipes = r
+}
+
+// noBrewingRecipes is the BrewingRecipes used if none were registered. No potions can be brewed with it.
+type noBrewingRecipes struct{}
+
+func (noBrewingRecipes) Brew(item.Stack, item.Stack, string) (item.Stack, bool) {
+	return item.Stack{}, false
+}
+func (noBrewingRecipes) BrewingReagent(item.Stack, string) bool { return false }
+
+// brewer is a struct that may be embedded by blocks that can brew potions, such as brewing stands. The inventory of a
+// brewer holds the ingredient in slot 0, the three bottles in slots 1 to 3 and the fuel in slot 4.
+type brewer struct {
+	mu sync.Mutex
+
+	viewers   map[ContainerViewer]struct{}
+	inventory *inventory.Inventory
+
+	brewDuration time.Duration
+	fuelAmount   int32
+	fuelTotal    int32
+	ingredient   item.Stack
+}
+
+// newBrewer initializes a new brewer and returns it.
+func newBrewer() *brewer {
+	b := &brewer{viewers: make(map[ContainerViewer]struct{})}
+	b.inventory = inventory.New(5, func(slot int, _, item item.Stack) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for viewer := range b.viewers {
+			viewer.ViewSlotChange(slot, item)
+		}
+	})
+	return b
+}
+
+// BrewDuration returns the remaining duration of the potions currently being brewed. If no potions are being brewed,
+// 0 is returned.
+func (b *brewer) BrewDuration() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.brewDuration
+}
+
+// Fuel returns the number of potions that may still be brewed using the fuel already consumed, and the total number
+// of potions that the last fuel consumed could brew.
+func (b *brewer) Fuel() (amount, total int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.fuelAmount, b.fuelTotal
+}
+
+// Inventory returns the inventory of the brewer.
+func (b *brewer) Inventory() *inventory.Inventory {
+	return b.inventory
+}
+
+// AddViewer adds a viewer to the brewer, so that it is updated whenever the inventory of the brewer is changed.
+func (b *brewer) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the brewer, so that slot updates in the inventory are no longer sent to it.
+func (b *brewer) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.viewers, v)
+}
+
+// InsertSlots returns the ingredient slot of the brewer for brewing ingredients inserted through the top face. Items
+// inserted through any of the other faces are put in the fuel slot if they are blaze powder, or in the bottle slots if
+// they are potions.
+func (b *brewer) InsertSlots(face cube.Face, it item.Stack) []int {
+	if face == cube.FaceUp {
+		if brewingRecipes.BrewingReagent(it, "brewing_stand") {
+			return []int{0}
+		}
+		return nil
+	}
+	switch it.Item().(type) {
+	case item.BlazePowder:
+		return []int{4}
+	case item.Potion, item.SplashPotion, item.LingeringPotion:
+		return []int{1, 2, 3}
+	}
+	return nil
+}
+
+// ExtractSlots returns the bottle slots of the brewer, regardless of the face passed.
+func (b *brewer) ExtractSlots(cube.Face) []int {
+	return []int{1, 2, 3}
+}
+
+// setBrewing sets the remaining brew duration and fuel of the brewer to the values passed.
+func (b *brewer) setBrewing(duration time.Duration, fuelAmount, fuelTotal int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.brewDuration, b.fuelAmount, b.fuelTotal = duration, fuelAmount, fuelTotal
+	if duration > 0 {
+		b.ingredient, _ = b.inventory.Item(0)
+	}
+}
+
+// tickBrewing ticks the brewer, consuming fuel to start brewing the bottles if the ingredient can be brewed with at
+// least one of them, and brewing the bottles once the brew duration has passed. The block passed is the block used to
+// look up brewing recipes. tickBrewing returns true if the potions finished brewing this tick.
+func (b *brewer) tickBrewing(block string) bool {
+	b.mu.Lock()
+
+	prevBrewDuration, prevFuelAmount, prevFuelTotal := b.brewDuration, b.fuelAmount, b.fuelTotal
+
+	ingredient, _ := b.inventory.Item(0)
+	fuel, _ := b.inventory.Item(4)
+
+	// Refill the fuel of the brewer if it has run out.
+	if _, ok := fuel.Item().(item.BlazePowder); ok && b.fuelAmount <= 0 {
+		b.fuelAmount, b.fuelTotal = brewingFuel, brewingFuel
+		defer b.inventory.SetItem(4, fuel.Grow(-1))
+	}
+
+	var (
+		outputs [3]item.Stack
+		canBrew bool
+	)
+	for i := range outputs {
+		bottle, _ := b.inventory.Item(i + 1)
+		if output, ok := brewingRecipes.Brew(bottle, ingredient, block); ok {
+			outputs[i], canBrew = output, true
+		}
+	}
+
+	var brewed bool
+	switch {
+	case b.brewDuration > 0 && (!canBrew || !ingredient.Comparable(b.ingredient)):
+		// The ingredient was changed or the bottles were removed while brewing, so the brewing is cancelled.
+		b.brewDuration = 0
+	case b.brewDuration > 0:
+		b.brewDuration -= time.Millisecond * 50
+		if b.brewDuration <= 0 {
+			b.brewDuration, brewed = 0, true
+			for i, output := range outputs {
+				if !output.Empty() {
+					defer b.inventory.SetItem(i+1, output)
+				}
+			}
+			defer b.inventory.SetItem(0, ingredient.Grow(-1))
+		}
+	case canBrew && b.fuelAmount > 0:
+		b.fuelAmount--
+		b.brewDuration, b.ingredient = brewingDuration, ingredient
+	}
+
+	// Update the viewers on the new brew duration and fuel.
+	for v := range b.viewers {
+		v.ViewBrewingUpdate(prevBrewDuration, b.brewDuration, prevFuelAmount, b.fuelAmount, prevFuelTotal, b.fuelTotal)
+	}
+
+	b.mu.Unlock()
+	return brewed
+}
@@ -0,0 +1,138 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// BrewingStand is a block used to brew potions, splash potions and lingering potions. Brewing stands are fuelled
+// using blaze powder and brew up to three bottles at a time.
+// The empty value of BrewingStand is not valid. It must be created using block.NewBrewingStand().
+type BrewingStand struct {
+	transparent
+	sourceWaterDisplacer
+	*brewer
+
+	// FirstBottle, SecondBottle and ThirdBottle are true if the first, second or third bottle slot of the brewing
+	// stand holds a bottle respectively.
+	FirstBottle, SecondBottle, ThirdBottle bool
+}
+
+// NewBrewingStand creates a new initialised brewing stand. The brewer is properly initialised.
+func NewBrewingStand() BrewingStand {
+	return BrewingStand{brewer: newBrewer()}
+}
+
+// Model ...
+func (BrewingStand) Model() world.BlockModel {
+	return model.BrewingStand{}
+}
+
+// LightEmissionLevel ...
+func (BrewingStand) LightEmissionLevel() uint8 {
+	return 1
+}
+
+// Tick is called to brew the potions in the brewing stand and update the bottles shown on it.
+func (b BrewingStand) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if b.brewer.tickBrewing("brewing_stand") {
+		w.PlaySound(pos.Vec3Centre(), sound.PotionBrewed{})
+	}
+	bottles := [3]bool{}
+	for i := range bottles {
+		it, _ := b.Inventory().Item(i + 1)
+		bottles[i] = !it.Empty()
+	}
+	if bottles != [3]bool{b.FirstBottle, b.SecondBottle, b.ThirdBottle} {
+		b.FirstBottle, b.SecondBottle, b.ThirdBottle = bottles[0], bottles[1], bottles[2]
+		w.SetBlock(pos, b, nil)
+	}
+}
+
+// UseOnBlock ...
+func (b BrewingStand) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+
+	place(w, pos, NewBrewingStand(), user, ctx)
+	return placed(ctx)
+}
+
+// Activate ...
+func (b BrewingStand) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// BreakInfo ...
+func (b BrewingStand) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, pickaxeHarvestable, pickaxeEffective, oneOf(BrewingStand{}))
+}
+
+// EncodeItem ...
+func (BrewingStand) EncodeItem() (name string, meta int16) {
+	return "minecraft:brewing_stand", 0
+}
+
+// EncodeBlock ...
+func (b BrewingStand) EncodeBlock() (name string, properties map[string]any) {
+	return "minecraft:brewing_stand", map[string]any{
+		"brewing_stand_slot_a_bit": b.FirstBottle,
+		"brewing_stand_slot_b_bit": b.SecondBottle,
+		"brewing_stand_slot_c_bit": b.ThirdBottle,
+	}
+}
+
+// EncodeNBT ...
+func (b BrewingStand) EncodeNBT() map[string]any {
+	if b.brewer == nil {
+		//noinspection GoAssignmentToReceiver
+		b = NewBrewingStand()
+	}
+	amount, total := b.Fuel()
+	return map[string]any{
+		"CookTime":   int16(b.BrewDuration().Milliseconds() / 50),
+		"FuelAmount": int16(amount),
+		"FuelTotal":  int16(total),
+		"Items":      nbtconv.InvToNBT(b.Inventory()),
+		"id":         "BrewingStand",
+	}
+}
+
+// DecodeNBT ...
+func (b BrewingStand) DecodeNBT(data map[string]any) any {
+	first, second, third := b.FirstBottle, b.SecondBottle, b.ThirdBottle
+
+	//noinspection GoAssignmentToReceiver
+	b = NewBrewingStand()
+	b.FirstBottle, b.SecondBottle, b.ThirdBottle = first, second, third
+	nbtconv.InvFromNBT(b.Inventory(), nbtconv.Slice[any](data, "Items"))
+	b.setBrewing(
+		nbtconv.TickDuration[int16](data, "CookTime"),
+		int32(nbtconv.Int16(data, "FuelAmount")),
+		int32(nbtconv.Int16(data, "FuelTotal")),
+	)
+	return b
+}
+
+// allBrewingStands ...
+func allBrewingStands() (stands []world.Block) {
+	for _, first := range []bool{false, true} {
+		for _, second := range []bool{false, true} {
+			for _, third := range []bool{false, true} {
+				stands = append(stands, BrewingStand{FirstBottle: first, SecondBottle: second, ThirdBottle: third})
+			}
+		}
+	}
+	return
+}
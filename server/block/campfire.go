@@ -0,0 +1,319 @@
+package block
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// campfireCookTime is the time it takes for a campfire to cook a food item.
+const campfireCookTime = time.Second * 30
+
+// Campfire is a block that can be used to cook food, pacify bees, act as a spread-proof light source, smoke signal or
+// damaging trap block.
+type Campfire struct {
+	transparent
+	bass
+	sourceWaterDisplacer
+
+	// Items represents the items in the campfire that are being cooked.
+	Items [4]CampfireItem
+	// Facing represents the direction that the campfire is facing.
+	Facing cube.Direction
+	// Extinguished is true if the campfire was extinguished by water, a shovel or a splash water potion.
+	Extinguished bool
+	// Type represents the type of campfire, which is either a normal campfire or a soul campfire.
+	Type FireType
+
+	cook *campfireCook
+}
+
+// CampfireItem holds an item being cooked on a campfire and the time left until it is cooked.
+type CampfireItem struct {
+	// Item is the item being cooked on the campfire.
+	Item item.Stack
+	// Time is the time left until the item is cooked at the moment the item was put on the campfire or the campfire
+	// was loaded. The time that the item has been cooking since is tracked by the campfire itself.
+	Time time.Duration
+}
+
+// campfireCook holds the time that each of the items on a campfire has been cooking. It is shared between all copies
+// of a campfire, so that the cooking progress may be updated every tick without updating the block in the world.
+type campfireCook struct {
+	mu      sync.Mutex
+	elapsed [4]time.Duration
+}
+
+// reset resets the time that the item in the slot passed has been cooking.
+func (c *campfireCook) reset(slot int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.elapsed[slot] = 0
+}
+
+// timeLeft returns the time left until the item in the slot passed is cooked.
+func (c *campfireCook) timeLeft(slot int, v CampfireItem) time.Duration {
+	if c == nil {
+		return v.Time
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if left := v.Time - c.elapsed[slot]; left > 0 {
+		return left
+	}
+	return 0
+}
+
+// Model ...
+func (Campfire) Model() world.BlockModel {
+	return model.Campfire{}
+}
+
+// SideClosed ...
+func (Campfire) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (c Campfire) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, axeEffective, func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		var drops []item.Stack
+		if hasSilkTouch(enchantments) {
+			drops = append(drops, item.NewStack(Campfire{Type: c.Type}, 1))
+		} else if c.Type == SoulFire() {
+			drops = append(drops, item.NewStack(SoulSoil{}, 1))
+		} else {
+			drops = append(drops, item.NewStack(item.Charcoal{}, 2))
+		}
+		for _, v := range c.Items {
+			if !v.Item.Empty() {
+				drops = append(drops, v.Item)
+			}
+		}
+		return drops
+	})
+}
+
+// FlammabilityInfo ...
+func (Campfire) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(0, 0, true)
+}
+
+// LightEmissionLevel ...
+func (c Campfire) LightEmissionLevel() uint8 {
+	if c.Extinguished {
+		return 0
+	}
+	return c.Type.LightLevel()
+}
+
+// Ignite lights the campfire if it was extinguished and is not in water.
+func (c Campfire) Ignite(pos cube.Pos, w *world.World, _ world.Entity) bool {
+	if !c.Extinguished {
+		return false
+	}
+	if _, ok := w.Liquid(pos); ok {
+		return false
+	}
+	c.Extinguished = false
+	w.SetBlock(pos, c, nil)
+	return true
+}
+
+// Extinguish extinguishes the campfire if it was lit. True is returned if the campfire was extinguished.
+func (c Campfire) Extinguish(pos cube.Pos, w *world.World) bool {
+	if c.Extinguished {
+		return false
+	}
+	c.Extinguished = true
+	w.PlaySound(pos.Vec3Centre(), sound.FireExtinguish{})
+	w.SetBlock(pos, c, nil)
+	return true
+}
+
+// Activate ...
+func (c Campfire) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if held.Empty() {
+		return false
+	}
+	if _, ok := held.Item().(item.Shovel); ok {
+		if c.Extinguish(pos, w) {
+			ctx.DamageItem(1)
+			return true
+		}
+		return false
+	}
+	food, ok := held.Item().(item.Smeltable)
+	if !ok || !food.SmeltInfo().Food {
+		return false
+	}
+	for i, v := range c.Items {
+		if v.Item.Empty() {
+			if c.cook == nil {
+				c.cook = &campfireCook{}
+			}
+			c.cook.reset(i)
+			c.Items[i] = CampfireItem{Item: held.Grow(1 - held.Count()), Time: campfireCookTime}
+			ctx.SubtractFromCount(1)
+			w.SetBlock(pos, c, nil)
+			return true
+		}
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (c Campfire) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, c)
+	if !used {
+		return false
+	}
+	if _, ok := w.Block(pos.Side(cube.FaceDown)).(Campfire); ok {
+		return false
+	}
+	c.Facing = user.Rotation().Direction().Opposite()
+	if liquid, ok := w.Liquid(pos); ok && liquid.LiquidType() == "water" {
+		c.Extinguished = true
+	}
+	c.cook = &campfireCook{}
+
+	place(w, pos, c, user, ctx)
+	return placed(ctx)
+}
+
+// Tick cooks the items on the campfire, dropping them once they are cooked, and shows smoke above it. Campfires in
+// water are extinguished.
+func (c Campfire) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if c.Extinguished {
+		return
+	}
+	if liquid, ok := w.Liquid(pos); ok && liquid.LiquidType() == "water" {
+		c.Extinguish(pos, w)
+		return
+	}
+	if rand.Float64() <= 0.016 {
+		// Play the crackling sound roughly every three seconds.
+		w.PlaySound(pos.Vec3Centre(), sound.CampfireCrackle{})
+	}
+	if rand.Float64() <= 0.1 {
+		_, tall := w.Block(pos.Side(cube.FaceDown)).(HayBale)
+		w.AddParticle(pos.Vec3Middle().Add(mgl64.Vec3{rand.Float64()/3 - 1.0/6, 0.5, rand.Float64()/3 - 1.0/6}), particle.CampfireSmoke{Tall: tall})
+	}
+
+	// The cooking progress is kept in the cook state shared by all copies of the campfire, so that the block only
+	// needs to be updated in the world once an item is done cooking.
+	updated := false
+	if c.cook == nil {
+		c.cook, updated = &campfireCook{}, true
+	}
+	var cooked []int
+	c.cook.mu.Lock()
+	for i, v := range c.Items {
+		if v.Item.Empty() {
+			continue
+		}
+		if c.cook.elapsed[i] += time.Millisecond * 50; c.cook.elapsed[i] >= v.Time {
+			c.cook.elapsed[i] = 0
+			cooked = append(cooked, i)
+		}
+	}
+	c.cook.mu.Unlock()
+
+	for _, i := range cooked {
+		if food, ok := c.Items[i].Item.Item().(item.Smeltable); ok {
+			dropItem(w, food.SmeltInfo().Product, pos.Vec3Middle())
+		}
+		c.Items[i] = CampfireItem{}
+	}
+	if updated || len(cooked) > 0 {
+		w.SetBlock(pos, c, nil)
+	}
+}
+
+// EntityInside damages entities standing in a lit campfire.
+func (c Campfire) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if c.Extinguished {
+		return
+	}
+	if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+		if _, ok := e.(flammableEntity); ok {
+			l.Hurt(c.Type.Damage(), FireDamageSource{})
+		}
+	}
+}
+
+// EncodeItem ...
+func (c Campfire) EncodeItem() (name string, meta int16) {
+	switch c.Type {
+	case NormalFire():
+		return "minecraft:campfire", 0
+	case SoulFire():
+		return "minecraft:soul_campfire", 0
+	}
+	panic("invalid fire type")
+}
+
+// EncodeBlock ...
+func (c Campfire) EncodeBlock() (name string, properties map[string]any) {
+	switch c.Type {
+	case NormalFire():
+		name = "minecraft:campfire"
+	case SoulFire():
+		name = "minecraft:soul_campfire"
+	default:
+		panic("invalid fire type")
+	}
+	return name, map[string]any{
+		"minecraft:cardinal_direction": c.Facing.String(),
+		"extinguished":                 boolByte(c.Extinguished),
+	}
+}
+
+// EncodeNBT ...
+func (c Campfire) EncodeNBT() map[string]any {
+	m := map[string]any{"id": "Campfire"}
+	for i, v := range c.Items {
+		if v.Item.Empty() {
+			continue
+		}
+		id := strconv.Itoa(i + 1)
+		m["Item"+id] = nbtconv.WriteItem(v.Item, true)
+		m["ItemTime"+id] = int32(c.cook.timeLeft(i, v).Milliseconds() / 50)
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (c Campfire) DecodeNBT(data map[string]any) any {
+	c.cook = &campfireCook{}
+	for i := range c.Items {
+		id := strconv.Itoa(i + 1)
+		c.Items[i] = CampfireItem{
+			Item: nbtconv.MapItem(data, "Item"+id),
+			Time: nbtconv.TickDuration[int32](data, "ItemTime"+id),
+		}
+	}
+	return c
+}
+
+// allCampfires ...
+func allCampfires() (campfires []world.Block) {
+	for _, d := range cube.Directions() {
+		for _, t := range FireTypes() {
+			campfires = append(campfires, Campfire{Facing: d, Type: t, Extinguished: true})
+			campfires = append(campfires, Campfire{Facing: d, Type: t})
+		}
+	}
+	return
+}
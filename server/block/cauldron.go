@@ -0,0 +1,372 @@
+package block
+
+import (
+	"image/color"
+	"math/rand"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Cauldron is a block that can hold water, lava, powder snow or potions. Water in a cauldron may be used to wash the
+// dye off leather armour and the patterns off banners, or be dyed itself to dye leather armour dipped into it.
+type Cauldron struct {
+	transparent
+
+	// Liquid is the liquid held by the cauldron. Cauldrons holding a potion or dyed water hold CauldronWater.
+	Liquid CauldronLiquid
+	// Level is the fill level of the cauldron. It ranges from 0 for an empty cauldron to 3 for a full one.
+	Level int
+	// Potion is the potion held by the cauldron. It is either an item.Potion, item.SplashPotion or
+	// item.LingeringPotion, or nil if the cauldron does not hold a potion.
+	Potion world.Item
+	// DyeColour is the colour of the dyed water in the cauldron. If the water is not dyed, the alpha channel of
+	// DyeColour is 0.
+	DyeColour color.RGBA
+}
+
+// Model ...
+func (Cauldron) Model() world.BlockModel {
+	return model.Cauldron{}
+}
+
+// BreakInfo ...
+func (c Cauldron) BreakInfo() BreakInfo {
+	return newBreakInfo(2, pickaxeHarvestable, pickaxeEffective, oneOf(Cauldron{}))
+}
+
+// UseOnBlock ...
+func (c Cauldron) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, c)
+	if !used {
+		return false
+	}
+
+	place(w, pos, Cauldron{}, user, ctx)
+	return placed(ctx)
+}
+
+// Activate ...
+func (c Cauldron) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	switch it := held.Item().(type) {
+	case item.Bucket:
+		return c.useBucket(pos, w, it, ctx)
+	case item.GlassBottle:
+		res, bottle, ok := c.FillBottle()
+		if !ok {
+			return false
+		}
+		w.SetBlock(pos, res, nil)
+		if c.Potion != nil {
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronTakePotion{})
+		} else {
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronTakeWater{})
+		}
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = bottle
+		return true
+	case item.Potion, item.SplashPotion, item.LingeringPotion:
+		return c.addPotion(pos, w, it, ctx)
+	case item.Dye:
+		res, ok := c.Dye(pos, u.Position(), it.Colour)
+		if !ok {
+			return false
+		}
+		w.SetBlock(pos, res, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronAddDye{})
+		ctx.SubtractFromCount(1)
+		return true
+	case Banner:
+		if !c.holdsWater() || len(it.Patterns) == 0 || it.Illager {
+			return false
+		}
+		it.Patterns = it.Patterns[:len(it.Patterns)-1]
+		w.SetBlock(pos, c.withLevel(c.Level-1), nil)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronCleanBanner{})
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = held.Grow(1 - held.Count()).WithItem(it)
+		return true
+	}
+	if col, ok := leatherColour(held.Item()); ok && c.Liquid == CauldronWater() && c.Level > 0 && c.Potion == nil {
+		switch {
+		case c.DyeColour.A != 0:
+			col = c.DyeColour
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronDyeArmour{})
+		case col != (color.RGBA{}):
+			col = color.RGBA{}
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronCleanArmour{})
+		default:
+			return false
+		}
+		w.SetBlock(pos, c.withLevel(c.Level-1), nil)
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = held.Grow(1 - held.Count()).WithItem(withLeatherColour(held.Item(), col))
+		return true
+	}
+	return false
+}
+
+// useBucket fills the cauldron with the liquid in the bucket passed, or fills the bucket with the liquid in the
+// cauldron if the bucket is empty.
+func (c Cauldron) useBucket(pos cube.Pos, w *world.World, b item.Bucket, ctx *item.UseContext) bool {
+	if b.Empty() {
+		if c.Level != 3 || c.Potion != nil {
+			return false
+		}
+		var liquid world.Liquid
+		switch c.Liquid {
+		case CauldronWater():
+			liquid = Water{Still: true, Depth: 8}
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronTakeWater{})
+		case CauldronLava():
+			liquid = Lava{Still: true, Depth: 8}
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronTakeLava{})
+		default:
+			return false
+		}
+		w.SetBlock(pos, Cauldron{}, nil)
+		ctx.NewItem = item.NewStack(item.Bucket{Content: item.LiquidBucketContent(liquid)}, 1)
+		ctx.NewItemSurvivalOnly = true
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	liquid, ok := b.Content.Liquid()
+	if _, fish := b.Content.Entity(); !ok || fish {
+		return false
+	}
+	res := Cauldron{Level: 3}
+	switch liquid.(type) {
+	case Water:
+		res.Liquid = CauldronWater()
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronFillWater{})
+	case Lava:
+		res.Liquid = CauldronLava()
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronFillLava{})
+	default:
+		return false
+	}
+	w.SetBlock(pos, res, nil)
+	ctx.NewItem = item.NewStack(item.Bucket{}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// addPotion adds the potion passed to the cauldron, raising its level by one. Water bottles may only be added to
+// empty cauldrons or cauldrons holding water, while other potions may only be added to empty cauldrons or cauldrons
+// holding the same potion.
+func (c Cauldron) addPotion(pos cube.Pos, w *world.World, p world.Item, ctx *item.UseContext) bool {
+	if c.Level >= 3 {
+		return false
+	}
+	if bottle, ok := p.(item.Potion); ok && bottle.Type == potion.Water() {
+		if c.Level > 0 && !c.holdsWater() {
+			return false
+		}
+		c.Liquid, c.Potion = CauldronWater(), nil
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronFillWater{})
+	} else {
+		if c.Level > 0 && (c.Liquid != CauldronWater() || c.Potion != p) {
+			return false
+		}
+		c.Liquid, c.Potion, c.DyeColour = CauldronWater(), p, color.RGBA{}
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronFillPotion{})
+	}
+	w.SetBlock(pos, c.withLevel(c.Level+1), nil)
+	ctx.SubtractFromCount(1)
+	ctx.NewItem = item.NewStack(item.GlassBottle{}, 1)
+	return true
+}
+
+// FillBottle ...
+func (c Cauldron) FillBottle() (world.Block, item.Stack, bool) {
+	if c.Level == 0 || c.Liquid != CauldronWater() || c.DyeColour.A != 0 {
+		return c, item.Stack{}, false
+	}
+	if c.Potion != nil {
+		return c.withLevel(c.Level - 1), item.NewStack(c.Potion, 1), true
+	}
+	return c.withLevel(c.Level - 1), item.NewStack(item.Potion{Type: potion.Water()}, 1), true
+}
+
+// Dye mixes the colour of the dye passed into the water held by the cauldron.
+func (c Cauldron) Dye(_ cube.Pos, _ mgl64.Vec3, col item.Colour) (world.Block, bool) {
+	if c.Level == 0 || c.Liquid != CauldronWater() || c.Potion != nil {
+		return c, false
+	}
+	dye := col.RGBA()
+	if c.DyeColour.A == 0 {
+		c.DyeColour = dye
+		return c, true
+	}
+	c.DyeColour = color.RGBA{
+		R: uint8((int(c.DyeColour.R) + int(dye.R)) / 2),
+		G: uint8((int(c.DyeColour.G) + int(dye.G)) / 2),
+		B: uint8((int(c.DyeColour.B) + int(dye.B)) / 2),
+		A: 0xff,
+	}
+	return c, true
+}
+
+// RandomTick fills the cauldron with water while it is raining above it, or with powder snow while it is snowing
+// above it.
+func (c Cauldron) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if c.Level >= 3 {
+		return
+	}
+	above := pos.Side(cube.FaceUp)
+	switch {
+	case w.RainingAt(above) && (c.Level == 0 || c.holdsWater()):
+		c.Liquid = CauldronWater()
+	case w.SnowingAt(above) && (c.Level == 0 || c.Liquid == CauldronPowderSnow()):
+		c.Liquid = CauldronPowderSnow()
+	default:
+		return
+	}
+	w.SetBlock(pos, c.withLevel(c.Level+1), nil)
+}
+
+// EntityInside sets entities inside a cauldron holding lava on fire and extinguishes burning entities inside a
+// cauldron holding water.
+func (c Cauldron) EntityInside(pos cube.Pos, _ *world.World, e world.Entity) {
+	if c.Level == 0 || e.Position()[1] >= float64(pos[1])+0.375+float64(c.Level)*0.1875 {
+		// The entity is not touching the contents of the cauldron.
+		return
+	}
+	flammable, ok := e.(flammableEntity)
+	if !ok {
+		return
+	}
+	switch c.Liquid {
+	case CauldronLava():
+		if fallEntity, ok := e.(fallDistanceEntity); ok {
+			fallEntity.ResetFallDistance()
+		}
+		if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+			l.Hurt(4, LavaDamageSource{})
+		}
+		flammable.SetOnFire(15 * time.Second)
+	case CauldronWater():
+		if flammable.OnFireDuration() > 0 {
+			flammable.Extinguish()
+		}
+	}
+}
+
+// holdsWater checks if the cauldron holds plain water, without a potion or dye in it.
+func (c Cauldron) holdsWater() bool {
+	return c.Level > 0 && c.Liquid == CauldronWater() && c.Potion == nil && c.DyeColour.A == 0
+}
+
+// withLevel returns the cauldron with its level changed to the level passed. If the level is 0, an empty cauldron
+// is returned.
+func (c Cauldron) withLevel(level int) Cauldron {
+	if level <= 0 {
+		return Cauldron{}
+	}
+	c.Level = level
+	return c
+}
+
+// EncodeItem ...
+func (Cauldron) EncodeItem() (name string, meta int16) {
+	return "minecraft:cauldron", 0
+}
+
+// EncodeBlock ...
+func (c Cauldron) EncodeBlock() (string, map[string]any) {
+	return "minecraft:cauldron", map[string]any{"cauldron_liquid": c.Liquid.String(), "fill_level": int32(c.Level * 2)}
+}
+
+// EncodeNBT ...
+func (c Cauldron) EncodeNBT() map[string]any {
+	m := map[string]any{"id": "Cauldron", "PotionId": int16(-1), "PotionType": int16(-1)}
+	switch p := c.Potion.(type) {
+	case item.Potion:
+		m["PotionId"], m["PotionType"] = int16(p.Type.Uint8()), int16(0)
+	case item.SplashPotion:
+		m["PotionId"], m["PotionType"] = int16(p.Type.Uint8()), int16(1)
+	case item.LingeringPotion:
+		m["PotionId"], m["PotionType"] = int16(p.Type.Uint8()), int16(2)
+	}
+	if c.DyeColour.A != 0 {
+		m["CustomColor"] = nbtconv.Int32FromRGBA(c.DyeColour)
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (c Cauldron) DecodeNBT(data map[string]any) any {
+	c.Potion, c.DyeColour = nil, color.RGBA{}
+	if id := nbtconv.Int16(data, "PotionId"); id >= 0 {
+		t := potion.From(int32(id))
+		switch nbtconv.Int16(data, "PotionType") {
+		case 0:
+			c.Potion = item.Potion{Type: t}
+		case 1:
+			c.Potion = item.SplashPotion{Type: t}
+		case 2:
+			c.Potion = item.LingeringPotion{Type: t}
+		}
+	}
+	if col, ok := data["CustomColor"].(int32); ok {
+		c.DyeColour = nbtconv.RGBAFromInt32(col)
+	}
+	return c
+}
+
+// leatherColour returns the colour of the leather armour passed. False is returned if the item passed is not leather
+// armour.
+func leatherColour(it world.Item) (color.RGBA, bool) {
+	var tier item.ArmourTier
+	switch a := it.(type) {
+	case item.Helmet:
+		tier = a.Tier
+	case item.Chestplate:
+		tier = a.Tier
+	case item.Leggings:
+		tier = a.Tier
+	case item.Boots:
+		tier = a.Tier
+	}
+	if leather, ok := tier.(item.ArmourTierLeather); ok {
+		return leather.Colour, true
+	}
+	return color.RGBA{}, false
+}
+
+// withLeatherColour returns the leather armour passed with its colour changed to the one passed. If the colour is
+// the zero value, the armour is no longer dyed.
+func withLeatherColour(it world.Item, col color.RGBA) world.Item {
+	tier := item.ArmourTierLeather{Colour: col}
+	switch it.(type) {
+	case item.Helmet:
+		return item.Helmet{Tier: tier}
+	case item.Chestplate:
+		return item.Chestplate{Tier: tier}
+	case item.Leggings:
+		return item.Leggings{Tier: tier}
+	case item.Boots:
+		return item.Boots{Tier: tier}
+	}
+	return it
+}
+
+// allCauldrons ...
+func allCauldrons() (cauldrons []world.Block) {
+	cauldrons = append(cauldrons, Cauldron{})
+	for _, liquid := range CauldronLiquids() {
+		for level := 1; level <= 3; level++ {
+			cauldrons = append(cauldrons, Cauldron{Liquid: liquid, Level: level})
+		}
+	}
+	return
+}
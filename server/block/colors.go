@@ -407,6 +407,14 @@ func (DoubleTallGrass) Color() color.RGBA {
 	return color.RGBA{86, 124, 77, 255}
 }
 
+func (Dispenser) Color() color.RGBA {
+	return color.RGBA{112, 112, 112, 255}
+}
+
+func (Dropper) Color() color.RGBA {
+	return color.RGBA{112, 112, 112, 255}
+}
+
 func (DragonEgg) Color() color.RGBA {
 	return color.RGBA{8, 8, 12, 255}
 }
@@ -447,6 +455,10 @@ func (Froglight) Color() color.RGBA {
 	return color.RGBA{252, 250, 205, 255}
 }
 
+func (FrostedIce) Color() color.RGBA {
+	return color.RGBA{140, 180, 250, 255}
+}
+
 func (Furnace) Color() color.RGBA {
 	return color.RGBA{104, 104, 104, 255}
 }
@@ -521,6 +533,10 @@ func (Honeycomb) Color() color.RGBA {
 	return color.RGBA{232, 140, 8, 255}
 }
 
+func (Hopper) Color() color.RGBA {
+	return color.RGBA{76, 76, 76, 255}
+}
+
 func (IronOre) Color() color.RGBA {
 	return color.RGBA{136, 127, 119, 255}
 }
@@ -637,6 +653,10 @@ func (Note) Color() color.RGBA {
 	return color.RGBA{90, 52, 32, 255}
 }
 
+func (Observer) Color() color.RGBA {
+	return color.RGBA{98, 98, 98, 255}
+}
+
 func (Obsidian) Color() color.RGBA {
 	return color.RGBA{6, 3, 11, 255}
 }
@@ -912,6 +932,10 @@ func (Stonecutter) Color() color.RGBA {
 	return color.RGBA{149, 149, 149, 255}
 }
 
+func (Target) Color() color.RGBA {
+	return color.RGBA{226, 170, 157, 255}
+}
+
 func (Terracotta) Color() color.RGBA {
 	return color.RGBA{152, 93, 67, 255}
 }
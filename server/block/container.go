@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/item/loot"
 	"github.com/df-mc/dragonfly/server/world"
 )
 
@@ -28,3 +29,98 @@ type Container interface {
 	RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos)
 	Inventory() *inventory.Inventory
 }
+
+// SidedContainer represents a Container that limits which slots of its inventory items may be moved into or out of
+// automatically, depending on the side of the block that the items are moved through. Furnaces, for example, only
+// accept fuel through their sides. Containers that do not implement SidedContainer allow items to be moved into and
+// out of any of their slots.
+type SidedContainer interface {
+	Container
+	// InsertSlots returns the slots of the inventory that the item.Stack passed may be inserted into when it enters
+	// the container through the face passed. Slots are attempted in the order returned.
+	InsertSlots(face cube.Face, it item.Stack) []int
+	// ExtractSlots returns the slots of the inventory that items may be extracted from when leaving the container
+	// through the face passed. Slots are attempted in the order returned.
+	ExtractSlots(face cube.Face) []int
+}
+
+// insertSlots returns the slots of the container that the item.Stack passed may be inserted into through the face
+// passed.
+func insertSlots(c Container, face cube.Face, it item.Stack) []int {
+	if sided, ok := c.(SidedContainer); ok {
+		return sided.InsertSlots(face, it)
+	}
+	return allSlots(c.Inventory())
+}
+
+// extractSlots returns the slots of the container that items may be extracted from through the face passed.
+func extractSlots(c Container, face cube.Face) []int {
+	if sided, ok := c.(SidedContainer); ok {
+		return sided.ExtractSlots(face)
+	}
+	return allSlots(c.Inventory())
+}
+
+// allSlots returns a slice holding all slots of the inventory passed.
+func allSlots(inv *inventory.Inventory) []int {
+	slots := make([]int, inv.Size())
+	for i := range slots {
+		slots[i] = i
+	}
+	return slots
+}
+
+// TransferItem moves a single item out of the src Container into the dst Container. srcFace is the face of the src
+// container that the item leaves through, and dstFace is the face of the dst container that the item enters
+// through. The slot rules of SidedContainer implementations are respected. TransferItem returns true if an item was
+// moved.
+func TransferItem(src, dst Container, srcFace, dstFace cube.Face) bool {
+	inv := src.Inventory()
+	for _, slot := range extractSlots(src, srcFace) {
+		it, err := inv.Item(slot)
+		if err != nil || it.Empty() {
+			continue
+		}
+		if InsertItem(dst, dstFace, it.Grow(1-it.Count())) {
+			_ = inv.SetItem(slot, it.Grow(-1))
+			return true
+		}
+	}
+	return false
+}
+
+// InsertItem inserts the item.Stack passed into the Container, provided the full stack can be inserted into a
+// single slot. face is the face of the container that the stack enters through. The slot rules of SidedContainer
+// implementations are respected. InsertItem returns true if the stack was inserted.
+func InsertItem(c Container, face cube.Face, it item.Stack) bool {
+	if it.Empty() {
+		return false
+	}
+	inv := c.Inventory()
+	for _, slot := range insertSlots(c, face, it) {
+		existing, err := inv.Item(slot)
+		if err != nil {
+			continue
+		}
+		if existing.Empty() {
+			_ = inv.SetItem(slot, it)
+			return true
+		}
+		if existing.Comparable(it) && existing.Count()+it.Count() <= existing.MaxCount() {
+			_ = inv.SetItem(slot, existing.Grow(it.Count()))
+			return true
+		}
+	}
+	return false
+}
+
+// FillContainer fills the Container at the position passed in the world with loot generated by the loot.Table
+// passed. False is returned if the block at the position is not a Container.
+func FillContainer(w *world.World, pos cube.Pos, t loot.Table, ctx loot.Context) bool {
+	c, ok := w.Block(pos).(Container)
+	if !ok {
+		return false
+	}
+	t.Fill(c.Inventory(), ctx)
+	return true
+}
@@ -18,7 +18,7 @@ type CopperOre struct {
 func (c CopperOre) BreakInfo() BreakInfo {
 	return newBreakInfo(c.Type.Hardness(), func(t item.Tool) bool {
 		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
-	}, pickaxeEffective, silkTouchDrop(item.NewStack(item.RawCopper{}, rand.Intn(4)+2), item.NewStack(c, 1))).withBlastResistance(9)
+	}, pickaxeEffective, fortuneOreDrop(item.NewStack(item.RawCopper{}, rand.Intn(4)+2), c)).withBlastResistance(9)
 }
 
 // SmeltInfo ...
@@ -0,0 +1,127 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// DetectorRail is a rail that emits a redstone signal while a minecart is riding over it.
+type DetectorRail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail. Detector rails cannot be curved.
+	Shape RailShape
+	// Powered is true if a minecart is currently on the rail, causing it to emit a redstone signal.
+	Powered bool
+}
+
+// RailShape returns the shape of the rail.
+func (r DetectorRail) RailShape() RailShape {
+	return r.Shape
+}
+
+// withRailShape ...
+func (r DetectorRail) withRailShape(s RailShape) world.Block {
+	r.Shape = s
+	return r
+}
+
+// MinecartRail ...
+func (DetectorRail) MinecartRail() {}
+
+// curvable ...
+func (DetectorRail) curvable() bool {
+	return false
+}
+
+// EntityInside powers the detector rail if the entity inside of it is a minecart.
+func (r DetectorRail) EntityInside(pos cube.Pos, w *world.World, e world.Entity) {
+	if r.Powered || !isMinecart(e) {
+		return
+	}
+	r.Powered = true
+	w.SetBlock(pos, r, nil)
+	w.ScheduleBlockUpdate(pos, time.Second)
+}
+
+// ScheduledTick checks if a minecart is still on the detector rail and stops emitting a signal if not.
+func (r DetectorRail) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if !r.Powered {
+		return
+	}
+	if minecartWithin(pos, w) {
+		w.ScheduleBlockUpdate(pos, time.Second)
+		return
+	}
+	r.Powered = false
+	w.SetBlock(pos, r, nil)
+}
+
+// RedstonePower returns 15 to all sides of the rail while a minecart is on it.
+func (r DetectorRail) RedstonePower(cube.Pos, cube.Face, *world.World) int {
+	if r.Powered {
+		return 15
+	}
+	return 0
+}
+
+// UseOnBlock ...
+func (r DetectorRail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	r.Powered = false
+	return placeRail(r, pos, face, w, user, ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r DetectorRail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	breakUnsupportedRail(r, pos, w)
+}
+
+// HasLiquidDrops ...
+func (DetectorRail) HasLiquidDrops() bool {
+	return true
+}
+
+// BreakInfo ...
+func (r DetectorRail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(DetectorRail{}))
+}
+
+// EncodeItem ...
+func (DetectorRail) EncodeItem() (name string, meta int16) {
+	return "minecraft:detector_rail", 0
+}
+
+// EncodeBlock ...
+func (r DetectorRail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:detector_rail", map[string]any{"rail_direction": int32(r.Shape.Uint8()), "rail_data_bit": boolByte(r.Powered)}
+}
+
+// allDetectorRails ...
+func allDetectorRails() (rails []world.Block) {
+	for _, s := range StraightRailShapes() {
+		rails = append(rails, DetectorRail{Shape: s})
+		rails = append(rails, DetectorRail{Shape: s, Powered: true})
+	}
+	return
+}
+
+// isMinecart checks if the entity passed is any kind of minecart.
+func isMinecart(e world.Entity) bool {
+	_, ok := e.Type().(MinecartEntityType)
+	return ok
+}
+
+// minecartWithin checks if there is a minecart within the block at the position passed.
+func minecartWithin(pos cube.Pos, w *world.World) bool {
+	for _, e := range w.EntitiesWithin(cube.Box(0, 0, 0, 1, 1, 1).Translate(pos.Vec3()), nil) {
+		if isMinecart(e) {
+			return true
+		}
+	}
+	return false
+}
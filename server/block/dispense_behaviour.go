@@ -0,0 +1,284 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// DispenseBehaviour is the behaviour of an item when it is dispensed by a dispenser. DispenseBehaviours are
+// registered per item using RegisterDispenseBehaviour. Items without a DispenseBehaviour are dropped in front of the
+// dispenser.
+type DispenseBehaviour interface {
+	// Dispense dispenses the item.Stack passed, which holds the full stack found in the slot of the dispenser that
+	// is being dispensed from. The item.Stack returned replaces the stack in that slot. If false is returned, the
+	// item could not be dispensed, the stack in the slot is left untouched and the dispenser plays a failure sound.
+	Dispense(src DispenseSource, it item.Stack) (item.Stack, bool)
+}
+
+// DispenseSource holds information on the dispenser that is dispensing an item.
+type DispenseSource struct {
+	// Pos is the position of the dispenser.
+	Pos cube.Pos
+	// Facing is the face of the dispenser that items are dispensed through.
+	Facing cube.Face
+	// World is the world that the dispenser is in.
+	World *world.World
+	// Inventory is the inventory of the dispenser. It may be used to store items produced while dispensing, such
+	// as filled buckets.
+	Inventory *inventory.Inventory
+}
+
+// Target returns the position of the block directly in front of the dispenser.
+func (src DispenseSource) Target() cube.Pos {
+	return src.Pos.Side(src.Facing)
+}
+
+// Position returns the position that entities dispensed by the dispenser should be spawned at.
+func (src DispenseSource) Position() mgl64.Vec3 {
+	return src.Pos.Vec3Centre().Add(src.Direction().Mul(0.7))
+}
+
+// Direction returns a unit vector pointing in the direction that the dispenser is facing.
+func (src DispenseSource) Direction() mgl64.Vec3 {
+	return cube.Pos{}.Side(src.Facing).Vec3()
+}
+
+// Rotation returns the cube.Rotation that entities dispensed by the dispenser should have.
+func (src DispenseSource) Rotation() cube.Rotation {
+	switch src.Facing {
+	case cube.FaceUp:
+		return cube.Rotation{0, -90}
+	case cube.FaceDown:
+		return cube.Rotation{0, 90}
+	case cube.FaceNorth:
+		return cube.Rotation{180, 0}
+	case cube.FaceEast:
+		return cube.Rotation{-90, 0}
+	case cube.FaceWest:
+		return cube.Rotation{90, 0}
+	}
+	return cube.Rotation{}
+}
+
+// store stores an item.Stack produced while dispensing in the inventory of the dispenser. If the inventory is full,
+// the stack is dropped in front of the dispenser instead.
+func (src DispenseSource) store(it item.Stack) {
+	if n, err := src.Inventory.AddItem(it); err != nil {
+		dropDispensed(src, it.Grow(-n))
+	}
+}
+
+// dispenseBehaviours holds all DispenseBehaviours registered using RegisterDispenseBehaviour, indexed by the name of
+// the item they were registered for.
+var dispenseBehaviours = map[string]DispenseBehaviour{}
+
+// RegisterDispenseBehaviour registers a DispenseBehaviour for the world.Item passed. The behaviour is used for all
+// items that share the name of the item passed, regardless of their metadata. A behaviour registered for an item that
+// already has one replaces the existing behaviour.
+func RegisterDispenseBehaviour(it world.Item, b DispenseBehaviour) {
+	name, _ := it.EncodeItem()
+	dispenseBehaviours[name] = b
+}
+
+// DispenseBehaviourFor returns the DispenseBehaviour registered for the world.Item passed. If no behaviour was
+// registered, false is returned.
+func DispenseBehaviourFor(it world.Item) (DispenseBehaviour, bool) {
+	name, _ := it.EncodeItem()
+	b, ok := dispenseBehaviours[name]
+	return b, ok
+}
+
+// DispenseBehaviourFunc is a function that implements DispenseBehaviour.
+type DispenseBehaviourFunc func(src DispenseSource, it item.Stack) (item.Stack, bool)
+
+// Dispense ...
+func (f DispenseBehaviourFunc) Dispense(src DispenseSource, it item.Stack) (item.Stack, bool) {
+	return f(src, it)
+}
+
+// dropDispensed drops the item.Stack passed in front of the dispenser, giving it a random velocity in the direction
+// that the dispenser is facing.
+func dropDispensed(src DispenseSource, it item.Stack) {
+	pos := src.Position()
+	if src.Facing.Axis() != cube.Y {
+		pos[1] -= 0.15625
+	}
+	speed := rand.Float64()*0.1 + 0.2
+	vel := src.Direction().Mul(speed).Add(mgl64.Vec3{rand.NormFloat64() * 0.045, rand.NormFloat64()*0.045 + 0.2, rand.NormFloat64() * 0.045})
+	src.World.AddEntity(src.World.EntityRegistry().Config().Item(it, pos, vel))
+}
+
+// projectileVelocity returns the velocity that a projectile dispensed by the dispenser should have.
+func projectileVelocity(src DispenseSource, force, uncertainty float64) mgl64.Vec3 {
+	dir := src.Direction().Add(mgl64.Vec3{0, 0.1, 0}).Normalize()
+	spread := mgl64.Vec3{rand.NormFloat64(), rand.NormFloat64(), rand.NormFloat64()}.Mul(0.0075 * uncertainty)
+	return dir.Add(spread).Mul(force)
+}
+
+// projectileDispenseBehaviour returns a DispenseBehaviour that shoots a projectile created using the function passed.
+func projectileDispenseBehaviour(force, uncertainty float64, f func(src DispenseSource, it item.Stack, vel mgl64.Vec3) world.Entity) DispenseBehaviour {
+	return DispenseBehaviourFunc(func(src DispenseSource, it item.Stack) (item.Stack, bool) {
+		src.World.AddEntity(f(src, it, projectileVelocity(src, force, uncertainty)))
+		src.World.PlaySound(src.Pos.Vec3Centre(), sound.Launch{})
+		return it.Grow(-1), true
+	})
+}
+
+// dispenseBucket places the liquid held by a bucket in front of the dispenser, or picks up the liquid source block in
+// front of the dispenser if the bucket is empty.
+func dispenseBucket(src DispenseSource, it item.Stack) (item.Stack, bool) {
+	b := it.Item().(item.Bucket)
+	w, target := src.World, src.Target()
+	if b.Empty() {
+		l, ok := w.Liquid(target)
+		if !ok || l.LiquidDepth() != 8 || l.LiquidFalling() {
+			return it, false
+		}
+		w.SetLiquid(target, nil)
+		w.PlaySound(target.Vec3Centre(), sound.BucketFill{Liquid: l})
+
+		filled := item.NewStack(item.Bucket{Content: item.LiquidBucketContent(l)}, 1)
+		if it.Count() == 1 {
+			return filled, true
+		}
+		src.store(filled)
+		return it.Grow(-1), true
+	}
+	l, ok := b.Content.Liquid()
+	if !ok {
+		return it, false
+	}
+	if d, ok := w.Block(target).(world.LiquidDisplacer); !replaceableWith(w, target, l) && (!ok || !d.CanDisplace(l)) {
+		return it, false
+	}
+	w.SetLiquid(target, l.WithDepth(8, false))
+	w.PlaySound(target.Vec3Centre(), sound.BucketEmpty{Liquid: l})
+	return item.NewStack(item.Bucket{}, 1), true
+}
+
+// dispenseTNT spawns a primed TNT entity in front of the dispenser.
+func dispenseTNT(src DispenseSource, it item.Stack) (item.Stack, bool) {
+	w, target := src.World, src.Target()
+	w.PlaySound(target.Vec3Centre(), sound.TNT{})
+	w.AddEntity(w.EntityRegistry().Config().TNT(target.Vec3Centre(), time.Second*4, nil))
+	return it.Grow(-1), true
+}
+
+// dispenseFlintAndSteel ignites the block in front of the dispenser, or places fire if that block is air.
+func dispenseFlintAndSteel(src DispenseSource, it item.Stack) (item.Stack, bool) {
+	w, target := src.World, src.Target()
+	if i, ok := w.Block(target).(interface {
+		Ignite(pos cube.Pos, w *world.World, igniter world.Entity) bool
+	}); ok && i.Ignite(target, w, nil) {
+		return it.Damage(1), true
+	}
+	if _, ok := w.Block(target).(Air); !ok {
+		return it, false
+	}
+	w.PlaySound(target.Vec3Centre(), sound.Ignite{})
+	w.SetBlock(target, Fire{Type: NormalFire()}, nil)
+	w.ScheduleBlockUpdate(target, time.Duration(30+rand.Intn(10))*time.Second/20)
+	return it.Damage(1), true
+}
+
+// dispenseBoneMeal uses bone meal on the block in front of the dispenser.
+func dispenseBoneMeal(src DispenseSource, it item.Stack) (item.Stack, bool) {
+	w, target := src.World, src.Target()
+	if bm, ok := w.Block(target).(item.BoneMealAffected); ok && bm.BoneMeal(target, w) {
+		w.AddParticle(target.Vec3(), particle.BoneMeal{})
+		return it.Grow(-1), true
+	}
+	return it, false
+}
+
+// armoured represents an entity that is able to wear armour, such as a player.
+type armoured interface {
+	world.Entity
+	// Armour returns the armour inventory of the entity.
+	Armour() *inventory.Armour
+}
+
+// dispenseArmour equips the armour piece dispensed on the first entity in front of the dispenser that does not yet
+// wear armour in the slot of the piece.
+func dispenseArmour(src DispenseSource, it item.Stack) (item.Stack, bool) {
+	w, target := src.World, src.Target()
+	box := cube.Box(0, 0, 0, 1, 1, 1).Translate(target.Vec3())
+	for _, e := range w.EntitiesWithin(box.Grow(2), nil) {
+		a, ok := e.(armoured)
+		if !ok || !e.Type().BBox(e).Translate(e.Position()).IntersectsWith(box) {
+			continue
+		}
+		inv, piece := a.Armour().Inventory(), it.Grow(1-it.Count())
+		for slot := 0; slot < 4; slot++ {
+			if existing, _ := inv.Item(slot); existing.Empty() && inv.SetItem(slot, piece) == nil {
+				return it.Grow(-1), true
+			}
+		}
+	}
+	return it, false
+}
+
+// minecartDispenseBehaviour returns a DispenseBehaviour that places the minecart created using the function passed
+// on the rail in front of the dispenser. If there is no rail in front of the dispenser, the minecart item is dropped.
+func minecartDispenseBehaviour(f func(w *world.World) func(pos mgl64.Vec3) world.Entity) DispenseBehaviour {
+	return DispenseBehaviourFunc(func(src DispenseSource, it item.Stack) (item.Stack, bool) {
+		w, target := src.World, src.Target()
+		if _, ok := w.Block(target).(RailBlock); !ok {
+			dropDispensed(src, it.Grow(1-it.Count()))
+			return it.Grow(-1), true
+		}
+		w.AddEntity(f(w)(target.Vec3Middle()))
+		return it.Grow(-1), true
+	})
+}
+
+// init registers the DispenseBehaviours of all vanilla items that have custom behaviour when dispensed.
+func init() {
+	RegisterDispenseBehaviour(item.Arrow{}, projectileDispenseBehaviour(1.1, 6, func(src DispenseSource, it item.Stack, vel mgl64.Vec3) world.Entity {
+		return src.World.EntityRegistry().Config().Arrow(src.Position(), vel, src.Rotation(), 2, nil, false, false, true, 0, it.Item().(item.Arrow).Tip)
+	}))
+	RegisterDispenseBehaviour(item.Snowball{}, projectileDispenseBehaviour(1.1, 6, func(src DispenseSource, _ item.Stack, vel mgl64.Vec3) world.Entity {
+		return src.World.EntityRegistry().Config().Snowball(src.Position(), vel, nil)
+	}))
+	RegisterDispenseBehaviour(item.Egg{}, projectileDispenseBehaviour(1.1, 6, func(src DispenseSource, _ item.Stack, vel mgl64.Vec3) world.Entity {
+		return src.World.EntityRegistry().Config().Egg(src.Position(), vel, nil)
+	}))
+	RegisterDispenseBehaviour(item.SplashPotion{}, projectileDispenseBehaviour(1.375, 3, func(src DispenseSource, it item.Stack, vel mgl64.Vec3) world.Entity {
+		return src.World.EntityRegistry().Config().SplashPotion(src.Position(), vel, it.Item().(item.SplashPotion).Type, nil)
+	}))
+	RegisterDispenseBehaviour(item.LingeringPotion{}, projectileDispenseBehaviour(1.375, 3, func(src DispenseSource, it item.Stack, vel mgl64.Vec3) world.Entity {
+		return src.World.EntityRegistry().Config().LingeringPotion(src.Position(), vel, it.Item().(item.LingeringPotion).Type, nil)
+	}))
+	for _, b := range []item.Bucket{{}, {Content: item.LiquidBucketContent(Water{})}, {Content: item.LiquidBucketContent(Lava{})}} {
+		RegisterDispenseBehaviour(b, DispenseBehaviourFunc(dispenseBucket))
+	}
+	RegisterDispenseBehaviour(TNT{}, DispenseBehaviourFunc(dispenseTNT))
+	RegisterDispenseBehaviour(item.FlintAndSteel{}, DispenseBehaviourFunc(dispenseFlintAndSteel))
+	RegisterDispenseBehaviour(item.BoneMeal{}, DispenseBehaviourFunc(dispenseBoneMeal))
+	for _, t := range item.ArmourTiers() {
+		RegisterDispenseBehaviour(item.Helmet{Tier: t}, DispenseBehaviourFunc(dispenseArmour))
+		RegisterDispenseBehaviour(item.Chestplate{Tier: t}, DispenseBehaviourFunc(dispenseArmour))
+		RegisterDispenseBehaviour(item.Leggings{Tier: t}, DispenseBehaviourFunc(dispenseArmour))
+		RegisterDispenseBehaviour(item.Boots{Tier: t}, DispenseBehaviourFunc(dispenseArmour))
+	}
+	RegisterDispenseBehaviour(item.TurtleShell{}, DispenseBehaviourFunc(dispenseArmour))
+	RegisterDispenseBehaviour(item.Minecart{}, minecartDispenseBehaviour(func(w *world.World) func(pos mgl64.Vec3) world.Entity {
+		return w.EntityRegistry().Config().Minecart
+	}))
+	RegisterDispenseBehaviour(item.ChestMinecart{}, minecartDispenseBehaviour(func(w *world.World) func(pos mgl64.Vec3) world.Entity {
+		return w.EntityRegistry().Config().ChestMinecart
+	}))
+	RegisterDispenseBehaviour(item.HopperMinecart{}, minecartDispenseBehaviour(func(w *world.World) func(pos mgl64.Vec3) world.Entity {
+		return w.EntityRegistry().Config().HopperMinecart
+	}))
+	RegisterDispenseBehaviour(item.TNTMinecart{}, minecartDispenseBehaviour(func(w *world.World) func(pos mgl64.Vec3) world.Entity {
+		return w.EntityRegistry().Config().TNTMinecart
+	}))
+}
@@ -0,0 +1,208 @@
+package block
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Dispenser is a block that dispenses items when it receives a redstone signal. Items are dispensed using the
+// DispenseBehaviour registered for them, such as shooting arrows or placing water. Items without a behaviour are
+// dropped in front of the dispenser.
+// The empty value of Dispenser is not valid. It must be created using block.NewDispenser().
+type Dispenser struct {
+	solid
+	bassDrum
+
+	// Facing is the direction that the dispenser dispenses items towards.
+	Facing cube.Face
+	// Triggered is true if the dispenser is currently receiving a redstone signal. A dispenser only dispenses an
+	// item when it becomes triggered.
+	Triggered bool
+	// CustomName is the custom name of the dispenser. This name is displayed when the dispenser is opened, and may
+	// include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+}
+
+// NewDispenser creates a new initialised dispenser. The inventory is properly initialised.
+func NewDispenser() Dispenser {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	return Dispenser{
+		inventory: inventory.New(9, func(slot int, _, item item.Stack) {
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot, item)
+			}
+		}),
+		viewerMu: m,
+		viewers:  v,
+	}
+}
+
+// Inventory returns the inventory of the dispenser. The size of the inventory will be 9.
+func (d Dispenser) Inventory() *inventory.Inventory {
+	return d.inventory
+}
+
+// WithName returns the dispenser after applying a specific name to the block.
+func (d Dispenser) WithName(a ...any) world.Item {
+	d.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return d
+}
+
+// AddViewer adds a viewer to the dispenser, so that it is updated whenever the inventory of the dispenser is changed.
+func (d Dispenser) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	d.viewerMu.Lock()
+	defer d.viewerMu.Unlock()
+	d.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the dispenser, so that slot updates in the inventory are no longer sent to
+// it.
+func (d Dispenser) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	d.viewerMu.Lock()
+	defer d.viewerMu.Unlock()
+	delete(d.viewers, v)
+}
+
+// NeighbourUpdateTick ...
+func (d Dispenser) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if powered := receivesRedstonePower(pos, w); powered != d.Triggered {
+		d.Triggered = powered
+		w.SetBlock(pos, d, nil)
+		if powered {
+			w.ScheduleBlockUpdate(pos, time.Second/5)
+		}
+	}
+}
+
+// ScheduledTick dispenses a random item out of the inventory of the dispenser.
+func (d Dispenser) ScheduledTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	slot, ok := randomOccupiedSlot(d.inventory, r)
+	if !ok {
+		w.PlaySound(pos.Vec3Centre(), sound.ClickFail{})
+		return
+	}
+	it, _ := d.inventory.Item(slot)
+	src := DispenseSource{Pos: pos, Facing: d.Facing, World: w, Inventory: d.inventory}
+	b, ok := DispenseBehaviourFor(it.Item())
+	if !ok {
+		dropDispensed(src, it.Grow(1-it.Count()))
+		w.PlaySound(pos.Vec3Centre(), sound.Click{})
+		_ = d.inventory.SetItem(slot, it.Grow(-1))
+		return
+	}
+	left, ok := b.Dispense(src, it)
+	if !ok {
+		w.PlaySound(pos.Vec3Centre(), sound.ClickFail{})
+		return
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.Click{})
+	_ = d.inventory.SetItem(slot, left)
+}
+
+// Activate ...
+func (d Dispenser) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (d Dispenser) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, d)
+	if !used {
+		return
+	}
+	//noinspection GoAssignmentToReceiver
+	d = NewDispenser()
+	d.Facing = calculateFace(user, pos)
+
+	place(w, pos, d, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (d Dispenser) BreakInfo() BreakInfo {
+	return newBreakInfo(3.5, pickaxeHarvestable, pickaxeEffective, oneOf(Dispenser{}))
+}
+
+// DecodeNBT ...
+func (d Dispenser) DecodeNBT(data map[string]any) any {
+	facing, triggered := d.Facing, d.Triggered
+	//noinspection GoAssignmentToReceiver
+	d = NewDispenser()
+	d.Facing, d.Triggered = facing, triggered
+	d.CustomName = nbtconv.String(data, "CustomName")
+	nbtconv.InvFromNBT(d.inventory, nbtconv.Slice[any](data, "Items"))
+	return d
+}
+
+// EncodeNBT ...
+func (d Dispenser) EncodeNBT() map[string]any {
+	if d.inventory == nil {
+		facing, triggered, customName := d.Facing, d.Triggered, d.CustomName
+		//noinspection GoAssignmentToReceiver
+		d = NewDispenser()
+		d.Facing, d.Triggered, d.CustomName = facing, triggered, customName
+	}
+	m := map[string]any{
+		"Items": nbtconv.InvToNBT(d.inventory),
+		"id":    "Dispenser",
+	}
+	if d.CustomName != "" {
+		m["CustomName"] = d.CustomName
+	}
+	return m
+}
+
+// EncodeItem ...
+func (Dispenser) EncodeItem() (name string, meta int16) {
+	return "minecraft:dispenser", 0
+}
+
+// EncodeBlock ...
+func (d Dispenser) EncodeBlock() (string, map[string]any) {
+	return "minecraft:dispenser", map[string]any{"facing_direction": int32(d.Facing), "triggered_bit": boolByte(d.Triggered)}
+}
+
+// randomOccupiedSlot returns a random slot of the inventory passed that holds an item. False is returned if the
+// inventory is empty.
+func randomOccupiedSlot(inv *inventory.Inventory, r *rand.Rand) (int, bool) {
+	var slots []int
+	for slot, it := range inv.Slots() {
+		if !it.Empty() {
+			slots = append(slots, slot)
+		}
+	}
+	if len(slots) == 0 {
+		return 0, false
+	}
+	return slots[r.Intn(len(slots))], true
+}
+
+// allDispensers ...
+func allDispensers() (dispensers []world.Block) {
+	for _, f := range cube.Faces() {
+		dispensers = append(dispensers, Dispenser{Facing: f})
+		dispensers = append(dispensers, Dispenser{Facing: f, Triggered: true})
+	}
+	return
+}
@@ -17,7 +17,7 @@ type EmeraldOre struct {
 func (e EmeraldOre) BreakInfo() BreakInfo {
 	i := newBreakInfo(e.Type.Hardness(), func(t item.Tool) bool {
 		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierIron.HarvestLevel
-	}, pickaxeEffective, silkTouchOneOf(item.Emerald{}, e)).withXPDropRange(3, 7)
+	}, pickaxeEffective, fortuneOreDrop(item.NewStack(item.Emerald{}, 1), e)).withXPDropRange(3, 7)
 	if e.Type == DeepslateOre() {
 		i = i.withBlastResistance(15)
 	}
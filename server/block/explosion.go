@@ -135,7 +135,7 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 		} else if breakable, ok := bl.(Breakable); ok {
 			w.SetBlock(pos, nil, nil)
 			if !c.DisableItemDrops && 1/c.Size > r.Float64() {
-				for _, drop := range breakable.BreakInfo().Drops(item.ToolNone{}, nil) {
+				for _, drop := range BreakDrops(breakable, item.ToolNone{}, nil) {
 					dropItem(w, drop, pos.Vec3Centre())
 				}
 			}
@@ -0,0 +1,80 @@
+package block
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// FrostedIce is a variant of ice created when an entity wearing boots enchanted with Frost Walker walks over
+// water. It ages over time and eventually melts back into water.
+type FrostedIce struct {
+	solid
+
+	// Age is the age of the frosted ice, ranging from 0-3. Frosted ice with an age of 3 melts into water when it
+	// ages further.
+	Age int
+}
+
+// ScheduledTick ...
+func (f FrostedIce) ScheduledTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if (r.Intn(3) == 0 || f.frostedNeighbours(pos, w) < 4) && w.Light(pos) > 11-uint8(f.Age)-f.LightDiffusionLevel() {
+		if f.Age == 3 {
+			w.SetBlock(pos, Water{Still: true, Depth: 8}, nil)
+			return
+		}
+		f.Age++
+		w.SetBlock(pos, f, nil)
+	}
+	w.ScheduleBlockUpdate(pos, time.Duration(20+r.Intn(20))*time.Second/20)
+}
+
+// frostedNeighbours returns the amount of frosted ice blocks horizontally and diagonally adjacent to the
+// position passed.
+func (FrostedIce) frostedNeighbours(pos cube.Pos, w *world.World) int {
+	n := 0
+	for x := -1; x <= 1; x++ {
+		for z := -1; z <= 1; z++ {
+			if x == 0 && z == 0 {
+				continue
+			}
+			if _, ok := w.Block(pos.Add(cube.Pos{x, 0, z})).(FrostedIce); ok {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// BreakInfo ...
+func (f FrostedIce) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, pickaxeEffective, simpleDrops()).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		w.SetBlock(pos, Water{Still: true, Depth: 8}, nil)
+	})
+}
+
+// Friction ...
+func (FrostedIce) Friction() float64 {
+	return 0.98
+}
+
+// LightDiffusionLevel ...
+func (FrostedIce) LightDiffusionLevel() uint8 {
+	return 2
+}
+
+// EncodeBlock ...
+func (f FrostedIce) EncodeBlock() (string, map[string]any) {
+	return "minecraft:frosted_ice", map[string]any{"age": int32(f.Age)}
+}
+
+// allFrostedIce returns all possible states of frosted ice.
+func allFrostedIce() (b []world.Block) {
+	for i := 0; i <= 3; i++ {
+		b = append(b, FrostedIce{Age: i})
+	}
+	return
+}
@@ -18,7 +18,7 @@ func (g Glowstone) Instrument() sound.Instrument {
 
 // BreakInfo ...
 func (g Glowstone) BreakInfo() BreakInfo {
-	return newBreakInfo(0.3, alwaysHarvestable, nothingEffective, silkTouchDrop(item.NewStack(item.GlowstoneDust{}, rand.Intn(3)+2), item.NewStack(g, 1)))
+	return newBreakInfo(0.3, alwaysHarvestable, nothingEffective, fortuneBonusDrop(item.NewStack(item.GlowstoneDust{}, rand.Intn(3)+2), g, 4))
 }
 
 // EncodeItem ...
@@ -17,7 +17,7 @@ type GoldOre struct {
 func (g GoldOre) BreakInfo() BreakInfo {
 	i := newBreakInfo(g.Type.Hardness(), func(t item.Tool) bool {
 		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierIron.HarvestLevel
-	}, pickaxeEffective, silkTouchOneOf(item.RawGold{}, g))
+	}, pickaxeEffective, fortuneOreDrop(item.NewStack(item.RawGold{}, 1), g))
 	if g.Type == DeepslateOre() {
 		i = i.withBlastResistance(9)
 	}
@@ -22,7 +22,9 @@ func (g Gravel) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 // BreakInfo ...
 func (g Gravel) BreakInfo() BreakInfo {
 	return newBreakInfo(0.6, alwaysHarvestable, shovelEffective, func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
-		if !hasSilkTouch(enchantments) && rand.Float64() < 0.1 {
+		// The chance of dropping flint increases with every level of fortune.
+		chance := [...]float64{0.1, 1 / 7.0, 0.25, 1}[min(fortuneLevel(enchantments), 3)]
+		if !hasSilkTouch(enchantments) && rand.Float64() < chance {
 			return []item.Stack{item.NewStack(item.Flint{}, 1)}
 		}
 		return []item.Stack{item.NewStack(g, 1)}
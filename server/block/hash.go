@@ -5,7 +5,8 @@ package block
 import "github.com/df-mc/dragonfly/server/world"
 
 const (
-	hashAir = iota
+	hashActivatorRail = iota
+	hashAir
 	hashAmethyst
 	hashAncientDebris
 	hashAndesite
@@ -17,6 +18,7 @@ const (
 	hashBarrier
 	hashBasalt
 	hashBeacon
+	hashBed
 	hashBedrock
 	hashBeetrootSeeds
 	hashBlackstone
@@ -24,13 +26,16 @@ const (
 	hashBlueIce
 	hashBone
 	hashBookshelf
+	hashBrewingStand
 	hashBricks
 	hashCactus
 	hashCake
 	hashCalcite
+	hashCampfire
 	hashCandle
 	hashCarpet
 	hashCarrot
+	hashCauldron
 	hashChain
 	hashChest
 	hashChiseledQuartz
@@ -53,16 +58,19 @@ const (
 	hashDeepslate
 	hashDeepslateBricks
 	hashDeepslateTiles
+	hashDetectorRail
 	hashDiamond
 	hashDiamondOre
 	hashDiorite
 	hashDirt
 	hashDirtPath
+	hashDispenser
 	hashDoubleFlower
 	hashDoubleTallGrass
 	hashDragonEgg
 	hashDriedKelp
 	hashDripstone
+	hashDropper
 	hashEmerald
 	hashEmeraldOre
 	hashEnchantingTable
@@ -74,6 +82,7 @@ const (
 	hashFletchingTable
 	hashFlower
 	hashFroglight
+	hashFrostedIce
 	hashFurnace
 	hashGlass
 	hashGlassPane
@@ -88,6 +97,7 @@ const (
 	hashHangingRoots
 	hashHayBale
 	hashHoneycomb
+	hashHopper
 	hashInvisibleBedrock
 	hashIron
 	hashIronBars
@@ -125,6 +135,7 @@ const (
 	hashNetherite
 	hashNetherrack
 	hashNote
+	hashObserver
 	hashObsidian
 	hashPackedIce
 	hashPackedMud
@@ -132,6 +143,7 @@ const (
 	hashPodzol
 	hashPolishedBlackstoneBrick
 	hashPotato
+	hashPoweredRail
 	hashPrismarine
 	hashPumpkin
 	hashPumpkinSeeds
@@ -140,6 +152,7 @@ const (
 	hashQuartz
 	hashQuartzBricks
 	hashQuartzPillar
+	hashRail
 	hashRawCopper
 	hashRawGold
 	hashRawIron
@@ -152,6 +165,7 @@ const (
 	hashSeaPickle
 	hashSeagrass
 	hashShroomlight
+	hashShulkerBox
 	hashSign
 	hashSkull
 	hashSlab
@@ -173,6 +187,7 @@ const (
 	hashSugarCane
 	hashTNT
 	hashTallGrass
+	hashTarget
 	hashTerracotta
 	hashTorch
 	hashTuff
@@ -199,6 +214,10 @@ func NextHash() uint64 {
 	return customBlockBase
 }
 
+func (ActivatorRail) BaseHash() uint64 {
+	return hashActivatorRail
+}
+
 func (Air) BaseHash() uint64 {
 	return hashAir
 }
@@ -247,6 +266,10 @@ func (Beacon) BaseHash() uint64 {
 	return hashBeacon
 }
 
+func (Bed) BaseHash() uint64 {
+	return hashBed
+}
+
 func (Bedrock) BaseHash() uint64 {
 	return hashBedrock
 }
@@ -275,6 +298,10 @@ func (Bookshelf) BaseHash() uint64 {
 	return hashBookshelf
 }
 
+func (BrewingStand) BaseHash() uint64 {
+	return hashBrewingStand
+}
+
 func (Bricks) BaseHash() uint64 {
 	return hashBricks
 }
@@ -291,6 +318,10 @@ func (Calcite) BaseHash() uint64 {
 	return hashCalcite
 }
 
+func (Campfire) BaseHash() uint64 {
+	return hashCampfire
+}
+
 func (Candle) BaseHash() uint64 {
 	return hashCandle
 }
@@ -303,6 +334,10 @@ func (Carrot) BaseHash() uint64 {
 	return hashCarrot
 }
 
+func (Cauldron) BaseHash() uint64 {
+	return hashCauldron
+}
+
 func (Chain) BaseHash() uint64 {
 	return hashChain
 }
@@ -391,6 +426,10 @@ func (DeepslateTiles) BaseHash() uint64 {
 	return hashDeepslateTiles
 }
 
+func (DetectorRail) BaseHash() uint64 {
+	return hashDetectorRail
+}
+
 func (Diamond) BaseHash() uint64 {
 	return hashDiamond
 }
@@ -411,6 +450,10 @@ func (DirtPath) BaseHash() uint64 {
 	return hashDirtPath
 }
 
+func (Dispenser) BaseHash() uint64 {
+	return hashDispenser
+}
+
 func (DoubleFlower) BaseHash() uint64 {
 	return hashDoubleFlower
 }
@@ -431,6 +474,10 @@ func (Dripstone) BaseHash() uint64 {
 	return hashDripstone
 }
 
+func (Dropper) BaseHash() uint64 {
+	return hashDropper
+}
+
 func (Emerald) BaseHash() uint64 {
 	return hashEmerald
 }
@@ -475,6 +522,10 @@ func (Froglight) BaseHash() uint64 {
 	return hashFroglight
 }
 
+func (FrostedIce) BaseHash() uint64 {
+	return hashFrostedIce
+}
+
 func (Furnace) BaseHash() uint64 {
 	return hashFurnace
 }
@@ -531,6 +582,10 @@ func (Honeycomb) BaseHash() uint64 {
 	return hashHoneycomb
 }
 
+func (Hopper) BaseHash() uint64 {
+	return hashHopper
+}
+
 func (InvisibleBedrock) BaseHash() uint64 {
 	return hashInvisibleBedrock
 }
@@ -679,6 +734,10 @@ func (Note) BaseHash() uint64 {
 	return hashNote
 }
 
+func (Observer) BaseHash() uint64 {
+	return hashObserver
+}
+
 func (Obsidian) BaseHash() uint64 {
 	return hashObsidian
 }
@@ -707,6 +766,10 @@ func (Potato) BaseHash() uint64 {
 	return hashPotato
 }
 
+func (PoweredRail) BaseHash() uint64 {
+	return hashPoweredRail
+}
+
 func (Prismarine) BaseHash() uint64 {
 	return hashPrismarine
 }
@@ -739,6 +802,10 @@ func (QuartzPillar) BaseHash() uint64 {
 	return hashQuartzPillar
 }
 
+func (Rail) BaseHash() uint64 {
+	return hashRail
+}
+
 func (RawCopper) BaseHash() uint64 {
 	return hashRawCopper
 }
@@ -787,6 +854,10 @@ func (Shroomlight) BaseHash() uint64 {
 	return hashShroomlight
 }
 
+func (ShulkerBox) BaseHash() uint64 {
+	return hashShulkerBox
+}
+
 func (Sign) BaseHash() uint64 {
 	return hashSign
 }
@@ -871,6 +942,10 @@ func (TallGrass) BaseHash() uint64 {
 	return hashTallGrass
 }
 
+func (Target) BaseHash() uint64 {
+	return hashTarget
+}
+
 func (Terracotta) BaseHash() uint64 {
 	return hashTerracotta
 }
@@ -927,6 +1002,10 @@ func (Wool) BaseHash() uint64 {
 	return hashWool
 }
 
+func (r ActivatorRail) Hash() uint64 {
+	return uint64(r.Shape.Uint8()) | uint64(boolByte(r.Powered))<<4
+}
+
 func (Air) Hash() uint64 {
 	return 0
 }
@@ -975,6 +1054,10 @@ func (Beacon) Hash() uint64 {
 	return 0
 }
 
+func (b Bed) Hash() uint64 {
+	return uint64(b.Facing) | uint64(boolByte(b.Head))<<2 | uint64(boolByte(b.Occupied))<<3
+}
+
 func (b Bedrock) Hash() uint64 {
 	return uint64(boolByte(b.InfiniteBurning))
 }
@@ -1003,6 +1086,10 @@ func (Bookshelf) Hash() uint64 {
 	return 0
 }
 
+func (b BrewingStand) Hash() uint64 {
+	return uint64(boolByte(b.FirstBottle)) | uint64(boolByte(b.SecondBottle))<<1 | uint64(boolByte(b.ThirdBottle))<<2
+}
+
 func (Bricks) Hash() uint64 {
 	return 0
 }
@@ -1019,6 +1106,10 @@ func (Calcite) Hash() uint64 {
 	return 0
 }
 
+func (c Campfire) Hash() uint64 {
+	return uint64(c.Facing) | uint64(boolByte(c.Extinguished))<<2 | uint64(c.Type.Uint8())<<3
+}
+
 func (c Candle) Hash() uint64 {
 	return uint64(c.AdditionalCount) | uint64(boolByte(c.Lit))<<8 | uint64(boolByte(c.Dyed))<<9 | uint64(c.Colour.Uint8())<<10
 }
@@ -1031,6 +1122,10 @@ func (c Carrot) Hash() uint64 {
 	return uint64(c.Growth)
 }
 
+func (c Cauldron) Hash() uint64 {
+	return uint64(c.Liquid.Uint8()) | uint64(c.Level)<<2
+}
+
 func (c Chain) Hash() uint64 {
 	return uint64(c.Axis)
 }
@@ -1119,6 +1214,10 @@ func (d DeepslateTiles) Hash() uint64 {
 	return uint64(boolByte(d.Cracked))
 }
 
+func (r DetectorRail) Hash() uint64 {
+	return uint64(r.Shape.Uint8()) | uint64(boolByte(r.Powered))<<4
+}
+
 func (Diamond) Hash() uint64 {
 	return 0
 }
@@ -1139,6 +1238,10 @@ func (DirtPath) Hash() uint64 {
 	return 0
 }
 
+func (d Dispenser) Hash() uint64 {
+	return uint64(d.Facing) | uint64(boolByte(d.Triggered))<<3
+}
+
 func (d DoubleFlower) Hash() uint64 {
 	return uint64(boolByte(d.UpperPart)) | uint64(d.Type.Uint8())<<1
 }
@@ -1159,6 +1262,10 @@ func (Dripstone) Hash() uint64 {
 	return 0
 }
 
+func (d Dropper) Hash() uint64 {
+	return uint64(d.Facing) | uint64(boolByte(d.Triggered))<<3
+}
+
 func (Emerald) Hash() uint64 {
 	return 0
 }
@@ -1203,6 +1310,10 @@ func (f Froglight) Hash() uint64 {
 	return uint64(f.Type.Uint8()) | uint64(f.Axis)<<2
 }
 
+func (f FrostedIce) Hash() uint64 {
+	return uint64(f.Age)
+}
+
 func (f Furnace) Hash() uint64 {
 	return uint64(f.Facing) | uint64(boolByte(f.Lit))<<3
 }
@@ -1259,6 +1370,10 @@ func (Honeycomb) Hash() uint64 {
 	return 0
 }
 
+func (h Hopper) Hash() uint64 {
+	return uint64(h.Facing) | uint64(boolByte(h.Powered))<<3
+}
+
 func (InvisibleBedrock) Hash() uint64 {
 	return 0
 }
@@ -1407,6 +1522,10 @@ func (Note) Hash() uint64 {
 	return 0
 }
 
+func (o Observer) Hash() uint64 {
+	return uint64(o.Facing) | uint64(boolByte(o.Powered))<<3
+}
+
 func (o Obsidian) Hash() uint64 {
 	return uint64(boolByte(o.Crying))
 }
@@ -1435,6 +1554,10 @@ func (p Potato) Hash() uint64 {
 	return uint64(p.Growth)
 }
 
+func (r PoweredRail) Hash() uint64 {
+	return uint64(r.Shape.Uint8()) | uint64(boolByte(r.Powered))<<4
+}
+
 func (p Prismarine) Hash() uint64 {
 	return uint64(p.Type.Uint8())
 }
@@ -1467,6 +1590,10 @@ func (q QuartzPillar) Hash() uint64 {
 	return uint64(q.Axis)
 }
 
+func (r Rail) Hash() uint64 {
+	return uint64(r.Shape.Uint8())
+}
+
 func (RawCopper) Hash() uint64 {
 	return 0
 }
@@ -1515,6 +1642,10 @@ func (Shroomlight) Hash() uint64 {
 	return 0
 }
 
+func (s ShulkerBox) Hash() uint64 {
+	return uint64(boolByte(s.Dyed)) | uint64(s.Colour.Uint8())<<1
+}
+
 func (s Sign) Hash() uint64 {
 	return uint64(s.Wood.Uint8()) | uint64(s.Attach.Uint8())<<4
 }
@@ -1599,6 +1730,10 @@ func (g TallGrass) Hash() uint64 {
 	return uint64(g.Type.Uint8())
 }
 
+func (Target) Hash() uint64 {
+	return 0
+}
+
 func (Terracotta) Hash() uint64 {
 	return 0
 }
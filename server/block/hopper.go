@@ -0,0 +1,230 @@
+package block
+
+import (
+	"fmt"
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+	"sync"
+)
+
+// hopperTransferCooldown is the amount of ticks that a hopper waits after moving an item before it moves the next.
+const hopperTransferCooldown = 8
+
+// Hopper is a low-capacity storage block that collects item entities directly above it. It pulls items out of the
+// container above it and pushes items into the container it is facing.
+// The empty value of Hopper is not valid. It must be created using block.NewHopper().
+type Hopper struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Facing is the direction that the hopper pushes items towards. A hopper can never face upwards.
+	Facing cube.Face
+	// Powered is true if the hopper receives redstone power. A powered hopper is locked: It neither pulls, pushes
+	// nor collects items.
+	Powered bool
+	// CustomName is the custom name of the hopper. This name is displayed when the hopper is opened, and may
+	// include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+	cooldown  *atomic.Int64
+}
+
+// NewHopper creates a new initialised hopper. The inventory is properly initialised.
+func NewHopper() Hopper {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	return Hopper{
+		inventory: inventory.New(5, func(slot int, _, item item.Stack) {
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot, item)
+			}
+		}),
+		viewerMu: m,
+		viewers:  v,
+		cooldown: atomic.NewInt64(0),
+	}
+}
+
+// Model ...
+func (Hopper) Model() world.BlockModel {
+	return model.Hopper{}
+}
+
+// Inventory returns the inventory of the hopper. The size of the inventory will be 5.
+func (h Hopper) Inventory() *inventory.Inventory {
+	return h.inventory
+}
+
+// WithName returns the hopper after applying a specific name to the block.
+func (h Hopper) WithName(a ...any) world.Item {
+	h.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return h
+}
+
+// SideClosed ...
+func (Hopper) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// AddViewer adds a viewer to the hopper, so that it is updated whenever the inventory of the hopper is changed.
+func (h Hopper) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	h.viewerMu.Lock()
+	defer h.viewerMu.Unlock()
+	h.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the hopper, so that slot updates in the inventory are no longer sent to
+// it.
+func (h Hopper) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	h.viewerMu.Lock()
+	defer h.viewerMu.Unlock()
+	delete(h.viewers, v)
+}
+
+// Tick moves items out of the container above the hopper and into the container that the hopper is facing, as long
+// as the hopper is not on cooldown or locked.
+func (h Hopper) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if h.cooldown.Load() > 0 {
+		h.cooldown.Dec()
+		return
+	}
+	if h.Powered {
+		return
+	}
+	pushed, pulled := h.push(pos, w), h.pull(pos, w)
+	if pushed || pulled {
+		h.cooldown.Store(hopperTransferCooldown)
+	}
+}
+
+// push moves a single item from the hopper into the container that the hopper is facing.
+func (h Hopper) push(pos cube.Pos, w *world.World) bool {
+	dst, ok := w.Block(pos.Side(h.Facing)).(Container)
+	if !ok || !TransferItem(h, dst, h.Facing, h.Facing.Opposite()) {
+		return false
+	}
+	if other, ok := dst.(Hopper); ok && other.cooldown != nil && other.cooldown.Load() == 0 {
+		// Items moving through a chain of hoppers are delayed in every hopper they pass through.
+		other.cooldown.Store(hopperTransferCooldown)
+	}
+	return true
+}
+
+// pull moves a single item from the container above the hopper into the hopper.
+func (h Hopper) pull(pos cube.Pos, w *world.World) bool {
+	src, ok := w.Block(pos.Side(cube.FaceUp)).(Container)
+	return ok && TransferItem(src, h, cube.FaceDown, cube.FaceUp)
+}
+
+// Collect collects as much of the item.Stack passed as possible into the inventory of the hopper. It is typically
+// called for item entities that are within the bowl of the hopper. The amount of items collected is returned. A
+// powered hopper never collects items.
+func (h Hopper) Collect(it item.Stack) int {
+	if h.Powered || h.inventory == nil {
+		return 0
+	}
+	n, _ := h.inventory.AddItem(it)
+	return n
+}
+
+// NeighbourUpdateTick ...
+func (h Hopper) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if powered := receivesRedstonePower(pos, w); powered != h.Powered {
+		h.Powered = powered
+		w.SetBlock(pos, h, nil)
+	}
+}
+
+// Activate ...
+func (h Hopper) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (h Hopper) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, h)
+	if !used {
+		return
+	}
+	//noinspection GoAssignmentToReceiver
+	h = NewHopper()
+	h.Facing = face.Opposite()
+	if h.Facing == cube.FaceUp {
+		h.Facing = cube.FaceDown
+	}
+	h.Powered = receivesRedstonePower(pos, w)
+
+	place(w, pos, h, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (h Hopper) BreakInfo() BreakInfo {
+	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, oneOf(Hopper{})).withBlastResistance(24)
+}
+
+// DecodeNBT ...
+func (h Hopper) DecodeNBT(data map[string]any) any {
+	facing, powered := h.Facing, h.Powered
+	//noinspection GoAssignmentToReceiver
+	h = NewHopper()
+	h.Facing, h.Powered = facing, powered
+	h.CustomName = nbtconv.String(data, "CustomName")
+	h.cooldown.Store(int64(nbtconv.Int32(data, "TransferCooldown")))
+	nbtconv.InvFromNBT(h.inventory, nbtconv.Slice[any](data, "Items"))
+	return h
+}
+
+// EncodeNBT ...
+func (h Hopper) EncodeNBT() map[string]any {
+	if h.inventory == nil {
+		facing, powered, customName := h.Facing, h.Powered, h.CustomName
+		//noinspection GoAssignmentToReceiver
+		h = NewHopper()
+		h.Facing, h.Powered, h.CustomName = facing, powered, customName
+	}
+	m := map[string]any{
+		"Items":            nbtconv.InvToNBT(h.inventory),
+		"TransferCooldown": int32(h.cooldown.Load()),
+		"id":               "Hopper",
+	}
+	if h.CustomName != "" {
+		m["CustomName"] = h.CustomName
+	}
+	return m
+}
+
+// EncodeItem ...
+func (Hopper) EncodeItem() (name string, meta int16) {
+	return "minecraft:hopper", 0
+}
+
+// EncodeBlock ...
+func (h Hopper) EncodeBlock() (string, map[string]any) {
+	return "minecraft:hopper", map[string]any{"facing_direction": int32(h.Facing), "toggle_bit": boolByte(h.Powered)}
+}
+
+// allHoppers ...
+func allHoppers() (hoppers []world.Block) {
+	for _, f := range cube.Faces() {
+		hoppers = append(hoppers, Hopper{Facing: f})
+		hoppers = append(hoppers, Hopper{Facing: f, Powered: true})
+	}
+	return
+}
@@ -17,7 +17,7 @@ type IronOre struct {
 func (i IronOre) BreakInfo() BreakInfo {
 	b := newBreakInfo(i.Type.Hardness(), func(t item.Tool) bool {
 		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
-	}, pickaxeEffective, silkTouchOneOf(item.RawIron{}, i))
+	}, pickaxeEffective, fortuneOreDrop(item.NewStack(item.RawIron{}, 1), i))
 	if i.Type == DeepslateOre() {
 		b = b.withBlastResistance(9)
 	}
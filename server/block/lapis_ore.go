@@ -18,7 +18,7 @@ type LapisOre struct {
 func (l LapisOre) BreakInfo() BreakInfo {
 	i := newBreakInfo(l.Type.Hardness(), func(t item.Tool) bool {
 		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
-	}, pickaxeEffective, silkTouchDrop(item.NewStack(item.LapisLazuli{}, rand.Intn(5)+4), item.NewStack(l, 1))).withXPDropRange(2, 5)
+	}, pickaxeEffective, fortuneOreDrop(item.NewStack(item.LapisLazuli{}, rand.Intn(5)+4), l)).withXPDropRange(2, 5)
 	if l.Type == DeepslateOre() {
 		i = i.withBlastResistance(9)
 	}
@@ -67,7 +67,7 @@ func (l Leaves) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
 			l.ShouldUpdate = false
 			w.SetBlock(pos, l, nil)
 		} else {
-			drops := l.BreakInfo().Drops(nil, nil)
+			drops := BreakDrops(l, nil, nil)
 			for _, drop := range drops {
 				dropItem(w, drop, pos.Vec3Centre())
 			}
@@ -171,7 +171,7 @@ func flowInto(b world.Liquid, src, pos cube.Pos, w *world.World, falling bool) b
 		}
 		if removable.HasLiquidDrops() {
 			if b, ok := existing.(Breakable); ok {
-				for _, d := range b.BreakInfo().Drops(item.ToolNone{}, nil) {
+				for _, d := range BreakDrops(b, item.ToolNone{}, nil) {
 					dropItem(w, d, pos.Vec3Centre())
 				}
 			} else {
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// BrewingStand is a model used by brewing stands. It consists of a thin base and a rod in the middle of the block.
+type BrewingStand struct{}
+
+// BBox ...
+func (BrewingStand) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{
+		cube.Box(0, 0, 0, 1, 0.125, 1),
+		cube.Box(0.4375, 0.125, 0.4375, 0.5625, 0.875, 0.5625),
+	}
+}
+
+// FaceSolid always returns false.
+func (BrewingStand) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
@@ -12,7 +12,7 @@ type NetherGoldOre struct {
 
 // BreakInfo ...
 func (n NetherGoldOre) BreakInfo() BreakInfo {
-	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, silkTouchDrop(item.NewStack(item.GoldNugget{}, rand.Intn(4)+2), item.NewStack(n, 1))).withXPDropRange(0, 1)
+	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, fortuneOreDrop(item.NewStack(item.GoldNugget{}, rand.Intn(4)+2), n)).withXPDropRange(0, 1)
 }
 
 // SmeltInfo ...
@@ -0,0 +1,84 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Observer is a block that watches the block in front of it and emits a short redstone pulse from its back when
+// that block changes.
+type Observer struct {
+	solid
+
+	// Facing is the direction that the face of the observer is looking towards. The observer watches the block on
+	// this side and emits its redstone pulse from the opposite side.
+	Facing cube.Face
+	// Powered is true if the observer is currently emitting a redstone pulse.
+	Powered bool
+}
+
+// NeighbourUpdateTick schedules a redstone pulse if the block observed by the observer changed.
+func (o Observer) NeighbourUpdateTick(pos, changedNeighbour cube.Pos, w *world.World) {
+	if changedNeighbour == pos.Side(o.Facing) {
+		w.ScheduleBlockUpdate(pos, time.Second/10)
+	}
+}
+
+// ScheduledTick turns the observer on if it was off, or off if it was on. An observer that is turned on schedules
+// another update to turn itself off again, resulting in a pulse of two ticks.
+func (o Observer) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	o.Powered = !o.Powered
+	w.SetBlock(pos, o, nil)
+	if o.Powered {
+		w.ScheduleBlockUpdate(pos, time.Second/10)
+	}
+}
+
+// RedstonePower returns 15 to the block behind the observer while it is emitting a pulse.
+func (o Observer) RedstonePower(_ cube.Pos, face cube.Face, _ *world.World) int {
+	if o.Powered && face == o.Facing.Opposite() {
+		return 15
+	}
+	return 0
+}
+
+// UseOnBlock ...
+func (o Observer) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, o)
+	if !used {
+		return
+	}
+	o.Facing = calculateFace(user, pos).Opposite()
+	o.Powered = false
+
+	place(w, pos, o, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (o Observer) BreakInfo() BreakInfo {
+	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, oneOf(Observer{}))
+}
+
+// EncodeItem ...
+func (Observer) EncodeItem() (name string, meta int16) {
+	return "minecraft:observer", 0
+}
+
+// EncodeBlock ...
+func (o Observer) EncodeBlock() (string, map[string]any) {
+	return "minecraft:observer", map[string]any{"minecraft:facing_direction": o.Facing.String(), "powered_bit": boolByte(o.Powered)}
+}
+
+// allObservers ...
+func allObservers() (observers []world.Block) {
+	for _, f := range cube.Faces() {
+		observers = append(observers, Observer{Facing: f})
+		observers = append(observers, Observer{Facing: f, Powered: true})
+	}
+	return
+}
@@ -0,0 +1,124 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// PoweredRail is a rail that accelerates minecarts riding over it while it is powered by redstone, and brakes them
+// while it is not. A powered rail passes its power on to up to 8 powered rails connected to it.
+type PoweredRail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail. Powered rails cannot be curved.
+	Shape RailShape
+	// Powered is true if the rail is currently powered by redstone.
+	Powered bool
+}
+
+// RailShape returns the shape of the rail.
+func (r PoweredRail) RailShape() RailShape {
+	return r.Shape
+}
+
+// withRailShape ...
+func (r PoweredRail) withRailShape(s RailShape) world.Block {
+	r.Shape = s
+	return r
+}
+
+// MinecartRail ...
+func (PoweredRail) MinecartRail() {}
+
+// curvable ...
+func (PoweredRail) curvable() bool {
+	return false
+}
+
+// UseOnBlock ...
+func (r PoweredRail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeRail(r, pos, face, w, user, ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r PoweredRail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !railSupported(pos, w) {
+		breakUnsupportedRail(r, pos, w)
+		return
+	}
+	if powered := railPowered(pos, r, w); powered != r.Powered {
+		r.Powered = powered
+		w.SetBlock(pos, r, nil)
+	}
+}
+
+// HasLiquidDrops ...
+func (PoweredRail) HasLiquidDrops() bool {
+	return true
+}
+
+// BreakInfo ...
+func (r PoweredRail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(PoweredRail{}))
+}
+
+// EncodeItem ...
+func (PoweredRail) EncodeItem() (name string, meta int16) {
+	return "minecraft:golden_rail", 0
+}
+
+// EncodeBlock ...
+func (r PoweredRail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:golden_rail", map[string]any{"rail_direction": int32(r.Shape.Uint8()), "rail_data_bit": boolByte(r.Powered)}
+}
+
+// allPoweredRails ...
+func allPoweredRails() (rails []world.Block) {
+	for _, s := range StraightRailShapes() {
+		rails = append(rails, PoweredRail{Shape: s})
+		rails = append(rails, PoweredRail{Shape: s, Powered: true})
+	}
+	return
+}
+
+// maxRailPowerDistance is the maximum amount of rails that redstone power is passed on over by powered and
+// activator rails.
+const maxRailPowerDistance = 8
+
+// railPowered checks if the rail at the position passed is powered. This is the case if it receives redstone power
+// directly or if one of the rails of the same type that it is connected to, at most maxRailPowerDistance rails away,
+// receives redstone power.
+func railPowered(pos cube.Pos, r RailBlock, w *world.World) bool {
+	if receivesRedstonePower(pos, w) {
+		return true
+	}
+	a, b := r.RailShape().Directions()
+	return railPoweredFrom(pos, r, a, w) || railPoweredFrom(pos, r, b, w)
+}
+
+// railPoweredFrom follows the rails of the same type as r, starting at the position passed and going in the direction
+// passed, and checks if any of them receives redstone power.
+func railPoweredFrom(pos cube.Pos, r RailBlock, d cube.Direction, w *world.World) bool {
+	name, _ := r.EncodeBlock()
+	for i := 0; i < maxRailPowerDistance; i++ {
+		np, n, ok := railNeighbour(pos, d, w)
+		if !ok || !n.RailShape().Connects(d.Opposite()) {
+			return false
+		}
+		if nName, _ := n.EncodeBlock(); nName != name {
+			return false
+		}
+		if receivesRedstonePower(np, w) {
+			return true
+		}
+		next, other := n.RailShape().Directions()
+		if next == d.Opposite() {
+			next = other
+		}
+		pos, d = np, next
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// RailBlock represents a rail block that minecarts are able to ride on.
+type RailBlock interface {
+	item.MinecartRail
+	// RailShape returns the current shape of the rail.
+	RailShape() RailShape
+}
+
+// MinecartEntityType represents the world.EntityType of a minecart. Detector rails are only activated by entities of
+// which the type implements MinecartEntityType.
+type MinecartEntityType interface {
+	world.EntityType
+	// Minecart is implemented by the types of all minecarts. It does nothing.
+	Minecart()
+}
+
+// shapedRail is a RailBlock of which the shape may be changed to connect to the rails around it.
+type shapedRail interface {
+	RailBlock
+	// withRailShape returns the rail with its shape changed to the shape passed.
+	withRailShape(s RailShape) world.Block
+	// curvable checks if the rail is able to take a curved shape.
+	curvable() bool
+}
+
+// Rail is a block that minecarts are able to ride on. Rails automatically connect to the rails next to them,
+// forming curves and slopes where needed.
+type Rail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail, specifying the directions that it connects to.
+	Shape RailShape
+}
+
+// RailShape returns the shape of the rail.
+func (r Rail) RailShape() RailShape {
+	return r.Shape
+}
+
+// withRailShape ...
+func (r Rail) withRailShape(s RailShape) world.Block {
+	r.Shape = s
+	return r
+}
+
+// MinecartRail ...
+func (Rail) MinecartRail() {}
+
+// curvable ...
+func (Rail) curvable() bool {
+	return true
+}
+
+// UseOnBlock ...
+func (r Rail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeRail(r, pos, face, w, user, ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r Rail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	breakUnsupportedRail(r, pos, w)
+}
+
+// HasLiquidDrops ...
+func (Rail) HasLiquidDrops() bool {
+	return true
+}
+
+// BreakInfo ...
+func (r Rail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(Rail{}))
+}
+
+// EncodeItem ...
+func (Rail) EncodeItem() (name string, meta int16) {
+	return "minecraft:rail", 0
+}
+
+// EncodeBlock ...
+func (r Rail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:rail", map[string]any{"rail_direction": int32(r.Shape.Uint8())}
+}
+
+// allRails ...
+func allRails() (rails []world.Block) {
+	for _, s := range RailShapes() {
+		rails = append(rails, Rail{Shape: s})
+	}
+	return
+}
+
+// placeRail places the rail passed at the position clicked and resolves its shape so that it connects to the rails
+// around it. The rail is initially aligned with the direction that the user is facing.
+func placeRail(r shapedRail, pos cube.Pos, face cube.Face, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, r)
+	if !used || !railSupported(pos, w) {
+		return false
+	}
+	shape := NorthSouthRail()
+	if d := user.Rotation().Direction(); d == cube.East || d == cube.West {
+		shape = EastWestRail()
+	}
+	r = r.withRailShape(shape).(shapedRail)
+
+	place(w, pos, r, user, ctx)
+	if !placed(ctx) {
+		return false
+	}
+	updateRailShape(pos, r, w)
+	return true
+}
+
+// railSupported checks if a rail at the position passed has a block below it to rest on.
+func railSupported(pos cube.Pos, w *world.World) bool {
+	below := pos.Side(cube.FaceDown)
+	return w.Block(below).Model().FaceSolid(below, cube.FaceUp, w)
+}
+
+// breakUnsupportedRail breaks the rail at the position passed if the block below it was removed.
+func breakUnsupportedRail(r RailBlock, pos cube.Pos, w *world.World) {
+	if !railSupported(pos, w) {
+		w.SetBlock(pos, nil, nil)
+		if it, ok := r.(world.Item); ok {
+			dropItem(w, item.NewStack(it, 1), pos.Vec3Centre())
+		}
+	}
+}
+
+// railNeighbour looks for a rail next to the position passed in the direction passed. Rails on the same level, one
+// block higher and one block lower are considered.
+func railNeighbour(pos cube.Pos, d cube.Direction, w *world.World) (cube.Pos, RailBlock, bool) {
+	side := pos.Side(d.Face())
+	for _, p := range [...]cube.Pos{side, side.Side(cube.FaceUp), side.Side(cube.FaceDown)} {
+		if r, ok := w.Block(p).(RailBlock); ok {
+			return p, r, true
+		}
+	}
+	return cube.Pos{}, nil, false
+}
+
+// railConnections returns the amount of rails, 0-2, that a rail at the position passed with the shape passed is
+// connected to.
+func railConnections(pos cube.Pos, s RailShape, w *world.World) (n int) {
+	a, b := s.Directions()
+	for _, d := range [...]cube.Direction{a, b} {
+		if _, r, ok := railNeighbour(pos, d, w); ok && r.RailShape().Connects(d.Opposite()) {
+			n++
+		}
+	}
+	return n
+}
+
+// railShapeFor resolves the shape that the rail passed should have at the position passed, based on the rails
+// around it. Rails that already have two other connections are not connected to.
+func railShapeFor(pos cube.Pos, r shapedRail, w *world.World) RailShape {
+	var connected [4]bool
+	for _, d := range cube.Directions() {
+		np, n, ok := railNeighbour(pos, d, w)
+		if ok && (n.RailShape().Connects(d.Opposite()) || railConnections(np, n.RailShape(), w) < 2) {
+			connected[d] = true
+		}
+	}
+	north, south, west, east := connected[cube.North], connected[cube.South], connected[cube.West], connected[cube.East]
+
+	shape := r.RailShape()
+	switch {
+	case (north || south) && !west && !east:
+		shape = NorthSouthRail()
+	case (west || east) && !north && !south:
+		shape = EastWestRail()
+	case r.curvable() && south && east:
+		shape = SouthEastRail()
+	case r.curvable() && south && west:
+		shape = SouthWestRail()
+	case r.curvable() && north && west:
+		shape = NorthWestRail()
+	case r.curvable() && north && east:
+		shape = NorthEastRail()
+	case west || east:
+		shape = EastWestRail()
+	}
+	if shape.Curved() {
+		return shape
+	}
+	a, b := shape.Directions()
+	if _, ok := w.Block(pos.Side(a.Face()).Side(cube.FaceUp)).(RailBlock); ok {
+		return ascendingRailShape(a)
+	} else if _, ok := w.Block(pos.Side(b.Face()).Side(cube.FaceUp)).(RailBlock); ok {
+		return ascendingRailShape(b)
+	}
+	if shape.Uint8() <= 1 {
+		return shape
+	}
+	// The rail was previously ascending, but no longer has a rail to ascend towards.
+	if a == cube.North {
+		return NorthSouthRail()
+	}
+	return EastWestRail()
+}
+
+// ascendingRailShape returns the rail shape ascending towards the direction passed.
+func ascendingRailShape(d cube.Direction) RailShape {
+	switch d {
+	case cube.North:
+		return AscendingNorthRail()
+	case cube.South:
+		return AscendingSouthRail()
+	case cube.West:
+		return AscendingWestRail()
+	}
+	return AscendingEastRail()
+}
+
+// updateRailShape resolves the shape of the rail at the position passed and updates the rails that it connects to
+// so that they connect back to it.
+func updateRailShape(pos cube.Pos, r shapedRail, w *world.World) {
+	shape := railShapeFor(pos, r, w)
+	if shape != r.RailShape() {
+		w.SetBlock(pos, r.withRailShape(shape), nil)
+	}
+	a, b := shape.Directions()
+	for _, d := range [...]cube.Direction{a, b} {
+		np, n, ok := railNeighbour(pos, d, w)
+		if !ok {
+			continue
+		}
+		sr, ok := n.(shapedRail)
+		if !ok {
+			continue
+		}
+		if n.RailShape().Connects(d.Opposite()) {
+			if _, ascending := n.RailShape().Ascending(); np.Y() >= pos.Y() || ascending {
+				// The rail already connects to us properly.
+				continue
+			}
+		} else if railConnections(np, n.RailShape(), w) >= 2 {
+			continue
+		}
+		if ns := railShapeFor(np, sr, w); ns != n.RailShape() {
+			w.SetBlock(np, sr.withRailShape(ns), nil)
+		}
+	}
+}
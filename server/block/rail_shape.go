@@ -0,0 +1,149 @@
+package block
+
+import "github.com/df-mc/dragonfly/server/block/cube"
+
+// RailShape represents the shape of a rail. It specifies the two directions that the rail connects to and if the
+// rail is ascending towards one of them.
+type RailShape struct {
+	railShape
+}
+
+// NorthSouthRail returns a flat rail going from north to south.
+func NorthSouthRail() RailShape {
+	return RailShape{0}
+}
+
+// EastWestRail returns a flat rail going from east to west.
+func EastWestRail() RailShape {
+	return RailShape{1}
+}
+
+// AscendingEastRail returns a rail going from west to east, that ascends towards the east.
+func AscendingEastRail() RailShape {
+	return RailShape{2}
+}
+
+// AscendingWestRail returns a rail going from east to west, that ascends towards the west.
+func AscendingWestRail() RailShape {
+	return RailShape{3}
+}
+
+// AscendingNorthRail returns a rail going from south to north, that ascends towards the north.
+func AscendingNorthRail() RailShape {
+	return RailShape{4}
+}
+
+// AscendingSouthRail returns a rail going from north to south, that ascends towards the south.
+func AscendingSouthRail() RailShape {
+	return RailShape{5}
+}
+
+// SouthEastRail returns a curved rail that connects the south and east sides of the block.
+func SouthEastRail() RailShape {
+	return RailShape{6}
+}
+
+// SouthWestRail returns a curved rail that connects the south and west sides of the block.
+func SouthWestRail() RailShape {
+	return RailShape{7}
+}
+
+// NorthWestRail returns a curved rail that connects the north and west sides of the block.
+func NorthWestRail() RailShape {
+	return RailShape{8}
+}
+
+// NorthEastRail returns a curved rail that connects the north and east sides of the block.
+func NorthEastRail() RailShape {
+	return RailShape{9}
+}
+
+// RailShapes returns all shapes of a rail, including curved shapes.
+func RailShapes() []RailShape {
+	return append(StraightRailShapes(), SouthEastRail(), SouthWestRail(), NorthWestRail(), NorthEastRail())
+}
+
+// StraightRailShapes returns all shapes of a rail that are not curved. These are the only shapes that powered,
+// detector and activator rails may have.
+func StraightRailShapes() []RailShape {
+	return []RailShape{NorthSouthRail(), EastWestRail(), AscendingEastRail(), AscendingWestRail(), AscendingNorthRail(), AscendingSouthRail()}
+}
+
+type railShape uint8
+
+// Uint8 returns the rail shape as a uint8.
+func (r railShape) Uint8() uint8 {
+	return uint8(r)
+}
+
+// Directions returns the two horizontal directions that the rail connects to.
+func (r railShape) Directions() (cube.Direction, cube.Direction) {
+	switch r {
+	case 0, 4, 5:
+		return cube.North, cube.South
+	case 1, 2, 3:
+		return cube.West, cube.East
+	case 6:
+		return cube.South, cube.East
+	case 7:
+		return cube.South, cube.West
+	case 8:
+		return cube.North, cube.West
+	case 9:
+		return cube.North, cube.East
+	}
+	panic("unknown rail shape")
+}
+
+// Connects checks if the rail connects to the direction passed.
+func (r railShape) Connects(d cube.Direction) bool {
+	a, b := r.Directions()
+	return a == d || b == d
+}
+
+// Ascending returns the direction that the rail ascends towards. If the rail is flat, false is returned.
+func (r railShape) Ascending() (cube.Direction, bool) {
+	switch r {
+	case 2:
+		return cube.East, true
+	case 3:
+		return cube.West, true
+	case 4:
+		return cube.North, true
+	case 5:
+		return cube.South, true
+	}
+	return 0, false
+}
+
+// Curved checks if the rail is curved, meaning it connects two directions that are not opposite each other.
+func (r railShape) Curved() bool {
+	return r > 5
+}
+
+// String ...
+func (r railShape) String() string {
+	switch r {
+	case 0:
+		return "north_south"
+	case 1:
+		return "east_west"
+	case 2:
+		return "ascending_east"
+	case 3:
+		return "ascending_west"
+	case 4:
+		return "ascending_north"
+	case 5:
+		return "ascending_south"
+	case 6:
+		return "south_east"
+	case 7:
+		return "south_west"
+	case 8:
+		return "north_west"
+	case 9:
+		return "north_east"
+	}
+	panic("unknown rail shape")
+}
@@ -0,0 +1,32 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// RedstoneSource represents a block that is able to emit a redstone signal to the blocks directly next to it.
+// Blocks such as hoppers read the signal to decide if they should be locked.
+type RedstoneSource interface {
+	// RedstonePower returns the power level, from 0-15, that the block at pos emits towards the neighbouring
+	// block found at the face passed. A power level of 0 means no power is emitted to that side at all.
+	RedstonePower(pos cube.Pos, face cube.Face, w *world.World) int
+}
+
+// receivedRedstonePower returns the highest redstone power level that the block at the position passed receives
+// from any of the blocks directly next to it.
+func receivedRedstonePower(pos cube.Pos, w *world.World) int {
+	var power int
+	for _, face := range cube.Faces() {
+		if src, ok := w.Block(pos.Side(face)).(RedstoneSource); ok {
+			power = max(power, src.RedstonePower(pos.Side(face), face.Opposite(), w))
+		}
+	}
+	return power
+}
+
+// receivesRedstonePower checks if the block at the position passed receives any redstone power from one of the
+// blocks directly next to it.
+func receivesRedstonePower(pos cube.Pos, w *world.World) bool {
+	return receivedRedstonePower(pos, w) > 0
+}
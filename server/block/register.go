@@ -103,6 +103,7 @@ func InitBlocks() {
 	world.RegisterBlock(Stone{Smooth: true})
 	world.RegisterBlock(Stone{})
 	world.RegisterBlock(TNT{})
+	world.RegisterBlock(Target{})
 	world.RegisterBlock(Terracotta{})
 	world.RegisterBlock(Tuff{})
 	world.RegisterBlock(Waterlily{})
@@ -117,21 +118,26 @@ func InitBlocks() {
 		world.RegisterBlock(LapisOre{Type: ore})
 	}
 
+	registerAll(allActivatorRails())
 	registerAll(allAnvils())
 	registerAll(allAzalea())
 	registerAll(allAzaleaLeaves())
 	registerAll(allBanners())
 	registerAll(allBarrels())
 	registerAll(allBasalt())
+	registerAll(allBeds())
 	registerAll(allBeetroot())
 	registerAll(allBlackstone())
 	registerAll(allBlastFurnaces())
 	registerAll(allBoneBlock())
+	registerAll(allBrewingStands())
 	registerAll(allCactus())
 	registerAll(allCake())
+	registerAll(allCampfires())
 	registerAll(allCandle())
 	registerAll(allCarpet())
 	registerAll(allCarrots())
+	registerAll(allCauldrons())
 	registerAll(allChains())
 	registerAll(allChests())
 	registerAll(allCocoaBeans())
@@ -143,9 +149,12 @@ func InitBlocks() {
 	registerAll(allCoralBlocks())
 	//registerAll(allCoralFan())
 	registerAll(allDeepslate())
+	registerAll(allDetectorRails())
+	registerAll(allDispensers())
 	registerAll(allDoors())
 	registerAll(allDoubleFlowers())
 	registerAll(allDoubleTallGrass())
+	registerAll(allDroppers())
 	registerAll(allEnderChests())
 	registerAll(allFarmland())
 	registerAll(allFence())
@@ -153,10 +162,12 @@ func InitBlocks() {
 	registerAll(allFire())
 	registerAll(allFlowers())
 	registerAll(allFroglight())
+	registerAll(allFrostedIce())
 	registerAll(allFurnaces())
 	registerAll(allGlazedTerracotta())
 	registerAll(allGrindstones())
 	registerAll(allHayBales())
+	registerAll(allHoppers())
 	registerAll(allItemFrames())
 	registerAll(allKelp())
 	registerAll(allLadders())
@@ -174,17 +185,21 @@ func InitBlocks() {
 	registerAll(allMushroomBlock())
 	registerAll(allNetherBricks())
 	registerAll(allNetherWart())
+	registerAll(allObservers())
 	registerAll(allPlanks())
+	registerAll(allPoweredRails())
 	registerAll(allPotato())
 	registerAll(allPrismarine())
 	registerAll(allPumpkinStems())
 	registerAll(allPumpkins())
 	registerAll(allPurpurs())
 	registerAll(allQuartz())
+	registerAll(allRails())
 	registerAll(allSandstones())
 	//registerAll(allSapling())
 	registerAll(allSeaPickles())
 	registerAll(allSeagrass())
+	registerAll(allShulkerBoxes())
 	registerAll(allSigns())
 	registerAll(allSkulls())
 	registerAll(allSlabs())
@@ -211,6 +226,7 @@ func InitBlocks() {
 
 func init() {
 	world.RegisterItem(Air{})
+	world.RegisterItem(ActivatorRail{})
 	world.RegisterItem(Amethyst{})
 	world.RegisterItem(AncientDebris{})
 	world.RegisterItem(Andesite{Polished: true})
@@ -230,11 +246,13 @@ func init() {
 	world.RegisterItem(BlueIce{})
 	world.RegisterItem(Bone{})
 	world.RegisterItem(Bookshelf{})
+	world.RegisterItem(BrewingStand{})
 	world.RegisterItem(Bricks{})
 	world.RegisterItem(Cactus{})
 	world.RegisterItem(Cake{})
 	world.RegisterItem(Calcite{})
 	world.RegisterItem(Carrot{})
+	world.RegisterItem(Cauldron{})
 	world.RegisterItem(Chain{})
 	world.RegisterItem(Chest{})
 	world.RegisterItem(ChiseledQuartz{})
@@ -250,14 +268,17 @@ func init() {
 	world.RegisterItem(DeepslateBricks{})
 	world.RegisterItem(DeepslateTiles{Cracked: true})
 	world.RegisterItem(DeepslateTiles{})
+	world.RegisterItem(DetectorRail{})
 	world.RegisterItem(Diamond{})
 	world.RegisterItem(Diorite{Polished: true})
 	world.RegisterItem(Diorite{})
 	world.RegisterItem(DirtPath{})
+	world.RegisterItem(Dispenser{})
 	world.RegisterItem(Dirt{Coarse: true})
 	world.RegisterItem(Dirt{})
 	world.RegisterItem(DragonEgg{})
 	world.RegisterItem(DriedKelp{})
+	world.RegisterItem(Dropper{})
 	world.RegisterItem(Dripstone{})
 	world.RegisterItem(Emerald{})
 	world.RegisterItem(EnchantingTable{})
@@ -278,6 +299,7 @@ func init() {
 	world.RegisterItem(HangingRoots{})
 	world.RegisterItem(HayBale{})
 	world.RegisterItem(Honeycomb{})
+	world.RegisterItem(Hopper{})
 	world.RegisterItem(InvisibleBedrock{})
 	world.RegisterItem(IronBars{})
 	world.RegisterItem(Iron{})
@@ -309,12 +331,14 @@ func init() {
 	world.RegisterItem(Note{Pitch: 24})
 	world.RegisterItem(Obsidian{Crying: true})
 	world.RegisterItem(Obsidian{})
+	world.RegisterItem(Observer{})
 	world.RegisterItem(PackedIce{})
 	world.RegisterItem(PackedMud{})
 	world.RegisterItem(Podzol{})
 	world.RegisterItem(PolishedBlackstoneBrick{Cracked: true})
 	world.RegisterItem(PolishedBlackstoneBrick{})
 	world.RegisterItem(Potato{})
+	world.RegisterItem(PoweredRail{})
 	world.RegisterItem(PumpkinSeeds{})
 	world.RegisterItem(Pumpkin{Carved: true})
 	world.RegisterItem(Pumpkin{})
@@ -324,6 +348,7 @@ func init() {
 	world.RegisterItem(QuartzPillar{})
 	world.RegisterItem(Quartz{Smooth: true})
 	world.RegisterItem(Quartz{})
+	world.RegisterItem(Rail{})
 	world.RegisterItem(RawCopper{})
 	world.RegisterItem(RawGold{})
 	world.RegisterItem(RawIron{})
@@ -347,6 +372,7 @@ func init() {
 	world.RegisterItem(Stone{})
 	world.RegisterItem(SugarCane{})
 	world.RegisterItem(TNT{})
+	world.RegisterItem(Target{})
 	world.RegisterItem(Terracotta{})
 	world.RegisterItem(Tuff{})
 	world.RegisterItem(Waterlily{})
@@ -381,6 +407,7 @@ func init() {
 	}
 	for _, c := range item.Colours() {
 		world.RegisterItem(Banner{Colour: c})
+		world.RegisterItem(Bed{Colour: c})
 		world.RegisterItem(Carpet{Colour: c})
 		world.RegisterItem(ConcretePowder{Colour: c})
 		world.RegisterItem(Concrete{Colour: c})
@@ -390,6 +417,10 @@ func init() {
 		world.RegisterItem(StainedTerracotta{Colour: c})
 		world.RegisterItem(Wool{Colour: c})
 	}
+	for _, w := range BoatWoodTypes() {
+		world.RegisterItem(Boat{Wood: w})
+		world.RegisterItem(Boat{Wood: w, Chest: true})
+	}
 	for _, w := range WoodTypes() {
 		if w != WarpedWood() && w != CrimsonWood() {
 			world.RegisterItem(Leaves{Wood: w, Persistent: true})
@@ -416,6 +447,7 @@ func init() {
 		world.RegisterItem(LapisOre{Type: ore})
 	}
 	for _, f := range FireTypes() {
+		world.RegisterItem(Campfire{Type: f})
 		world.RegisterItem(Lantern{Type: f})
 		world.RegisterItem(Torch{Type: f})
 	}
@@ -470,6 +502,11 @@ func init() {
 	for _, c := range item.Colours() {
 		world.RegisterItem(Candle{Colour: c, Dyed: true})
 	}
+
+	world.RegisterItem(ShulkerBox{Dyed: false})
+	for _, c := range item.Colours() {
+		world.RegisterItem(ShulkerBox{Colour: c, Dyed: true})
+	}
 }
 
 func registerAll(blocks []world.Block) {
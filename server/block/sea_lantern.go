@@ -19,7 +19,7 @@ func (SeaLantern) LightEmissionLevel() uint8 {
 
 // BreakInfo ...
 func (s SeaLantern) BreakInfo() BreakInfo {
-	return newBreakInfo(0.3, alwaysHarvestable, nothingEffective, silkTouchDrop(item.NewStack(item.PrismarineCrystals{}, rand.Intn(2)+2), item.NewStack(s, 1)))
+	return newBreakInfo(0.3, alwaysHarvestable, nothingEffective, fortuneBonusDrop(item.NewStack(item.PrismarineCrystals{}, rand.Intn(2)+2), s, 5))
 }
 
 // EncodeItem ...
@@ -0,0 +1,262 @@
+package block
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// ShulkerBox is a container block which may be used to store items. Unlike other containers, shulker boxes keep
+// their contents when broken, allowing them to be carried around as an item.
+// The empty value of ShulkerBox is not valid. It must be created using block.NewShulkerBox().
+type ShulkerBox struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Dyed specifies if the shulker box is dyed. If false, the shulker box is undyed and Colour is ignored.
+	Dyed bool
+	// Colour is the colour of the shulker box.
+	Colour item.Colour
+	// Facing is the direction that the lid of the shulker box opens towards.
+	Facing cube.Face
+	// CustomName is the custom name of the shulker box. This name is displayed when the shulker box is opened, and
+	// may include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+}
+
+// NewShulkerBox creates a new initialised shulker box. The inventory is properly initialised.
+func NewShulkerBox() ShulkerBox {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	inv := inventory.New(27, func(slot int, _, item item.Stack) {
+		m.RLock()
+		defer m.RUnlock()
+		for viewer := range v {
+			viewer.ViewSlotChange(slot, item)
+		}
+	})
+	inv.Handle(shulkerBoxHandler{})
+	return ShulkerBox{
+		Facing:    cube.FaceUp,
+		inventory: inv,
+		viewerMu:  m,
+		viewers:   v,
+	}
+}
+
+// Inventory returns the inventory of the shulker box. The size of the inventory will be 27.
+func (s ShulkerBox) Inventory() *inventory.Inventory {
+	return s.inventory
+}
+
+// WithName returns the shulker box after applying a specific name to the block.
+func (s ShulkerBox) WithName(a ...any) world.Item {
+	s.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return s
+}
+
+// MaxCount always returns 1.
+func (ShulkerBox) MaxCount() int {
+	return 1
+}
+
+// Model ...
+func (ShulkerBox) Model() world.BlockModel {
+	return model.Solid{}
+}
+
+// InsertSlots returns all slots of the shulker box, unless the item passed is a shulker box, which may never be
+// inserted into another shulker box.
+func (s ShulkerBox) InsertSlots(_ cube.Face, it item.Stack) []int {
+	if _, ok := it.Item().(ShulkerBox); ok {
+		return nil
+	}
+	return allSlots(s.inventory)
+}
+
+// ExtractSlots returns all slots of the shulker box, regardless of the face passed.
+func (s ShulkerBox) ExtractSlots(cube.Face) []int {
+	return allSlots(s.inventory)
+}
+
+// open opens the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) open(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, OpenAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxOpen{})
+}
+
+// close closes the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) close(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, CloseAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxClose{})
+}
+
+// AddViewer adds a viewer to the shulker box, so that it is updated whenever the inventory of the shulker box is
+// changed.
+func (s ShulkerBox) AddViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		s.open(w, pos)
+	}
+	s.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the shulker box, so that slot updates in the inventory are no longer sent to
+// it.
+func (s ShulkerBox) RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		return
+	}
+	delete(s.viewers, v)
+	if len(s.viewers) == 0 {
+		s.close(w, pos)
+	}
+}
+
+// Activate ...
+func (s ShulkerBox) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		// The lid of the shulker box cannot open if the block in front of it is solid.
+		side := pos.Side(s.Facing)
+		if !w.Block(side).Model().FaceSolid(side, s.Facing.Opposite(), w) {
+			opener.OpenBlockContainer(pos)
+		}
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (s ShulkerBox) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, s)
+	if !used {
+		return
+	}
+	b := s.withContents()
+	b.Facing = face
+
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (s ShulkerBox) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, pickaxeEffective, func(item.Tool, []item.Enchantment) []item.Stack {
+		return []item.Stack{item.NewStack(s.withContents(), 1)}
+	})
+}
+
+// withContents returns a new initialised shulker box with the same colour and custom name as the shulker box, holding
+// a copy of the contents of its inventory.
+func (s ShulkerBox) withContents() ShulkerBox {
+	b := NewShulkerBox()
+	b.Dyed, b.Colour, b.CustomName = s.Dyed, s.Colour, s.CustomName
+	if s.inventory != nil {
+		for slot, it := range s.inventory.Slots() {
+			_ = b.inventory.SetItem(slot, it)
+		}
+	}
+	return b
+}
+
+// DecodeNBT ...
+func (s ShulkerBox) DecodeNBT(data map[string]any) any {
+	dyed, colour := s.Dyed, s.Colour
+	//noinspection GoAssignmentToReceiver
+	s = NewShulkerBox()
+	s.Dyed, s.Colour = dyed, colour
+	if facing, ok := data["facing"].(byte); ok {
+		s.Facing = cube.Face(facing % 6)
+	}
+	s.CustomName = nbtconv.String(data, "CustomName")
+	nbtconv.InvFromNBT(s.inventory, nbtconv.Slice[any](data, "Items"))
+	return s
+}
+
+// EncodeNBT ...
+func (s ShulkerBox) EncodeNBT() map[string]any {
+	if s.inventory == nil {
+		facing := s.Facing
+		//noinspection GoAssignmentToReceiver
+		s = s.withContents()
+		s.Facing = facing
+	}
+	m := map[string]any{
+		"Items":  nbtconv.InvToNBT(s.inventory),
+		"facing": byte(s.Facing),
+		"id":     "ShulkerBox",
+	}
+	if s.CustomName != "" {
+		m["CustomName"] = s.CustomName
+	}
+	return m
+}
+
+// EncodeItem ...
+func (s ShulkerBox) EncodeItem() (name string, meta int16) {
+	name = "minecraft:undyed_"
+	if s.Dyed {
+		if s.Colour == item.ColourLightGrey() {
+			name = "minecraft:light_gray_"
+		} else {
+			name = "minecraft:" + s.Colour.String() + "_"
+		}
+	}
+	return name + "shulker_box", 0
+}
+
+// EncodeBlock ...
+func (s ShulkerBox) EncodeBlock() (name string, properties map[string]any) {
+	name = "minecraft:undyed_"
+	if s.Dyed {
+		if s.Colour == item.ColourLightGrey() {
+			name = "minecraft:light_gray_"
+		} else {
+			name = "minecraft:" + s.Colour.String() + "_"
+		}
+	}
+	return name + "shulker_box", nil
+}
+
+// shulkerBoxHandler is the inventory.Handler of the inventory of shulker boxes. It prevents shulker boxes from being
+// placed into other shulker boxes.
+type shulkerBoxHandler struct {
+	inventory.NopHandler
+}
+
+// HandlePlace ...
+func (shulkerBoxHandler) HandlePlace(ctx *event.Context, _ int, it item.Stack) {
+	if _, ok := it.Item().(ShulkerBox); ok {
+		ctx.Cancel()
+	}
+}
+
+// allShulkerBoxes ...
+func allShulkerBoxes() (boxes []world.Block) {
+	boxes = append(boxes, ShulkerBox{})
+	for _, c := range item.Colours() {
+		boxes = append(boxes, ShulkerBox{Dyed: true, Colour: c})
+	}
+	return
+}
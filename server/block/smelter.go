@@ -85,6 +85,23 @@ func (s *smelter) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
 	delete(s.viewers, v)
 }
 
+// InsertSlots returns the input slot of the smelter for items inserted through the top face, and the fuel slot for
+// fuel inserted through any of the other faces.
+func (s *smelter) InsertSlots(face cube.Face, it item.Stack) []int {
+	if face == cube.FaceUp {
+		return []int{0}
+	}
+	if _, ok := it.Item().(item.Fuel); ok {
+		return []int{1}
+	}
+	return nil
+}
+
+// ExtractSlots returns the product slot of the smelter, regardless of the face passed.
+func (s *smelter) ExtractSlots(cube.Face) []int {
+	return []int{2}
+}
+
 // setExperience sets the collected experience of the smelter to the given value.
 func (s *smelter) setExperience(xp int) {
 	s.mu.Lock()
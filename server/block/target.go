@@ -0,0 +1,83 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Target is a block that emits a temporary redstone signal when it is hit by a projectile. The closer the
+// projectile hits to the centre of the face, the stronger the signal.
+type Target struct {
+	solid
+
+	// Power is the redstone power level, from 0-15, that the target is currently emitting after being hit by a
+	// projectile.
+	Power int
+}
+
+// ProjectileHit updates the power emitted by the target based on how close to the centre of the face the
+// projectile hit the block. Arrows keep the target powered for a second, other projectiles for 8 ticks.
+func (t Target) ProjectileHit(pos cube.Pos, face cube.Face, hitPos mgl64.Vec3, w *world.World, e world.Entity) {
+	d := hitPos.Sub(pos.Vec3Centre())
+	var dist float64
+	switch face.Axis() {
+	case cube.X:
+		dist = math.Max(math.Abs(d[1]), math.Abs(d[2]))
+	case cube.Y:
+		dist = math.Max(math.Abs(d[0]), math.Abs(d[2]))
+	case cube.Z:
+		dist = math.Max(math.Abs(d[0]), math.Abs(d[1]))
+	}
+	t.Power = max(1, int(math.Ceil(15*mgl64.Clamp((0.5-dist)/0.5, 0, 1))))
+	w.SetBlock(pos, t, nil)
+
+	duration := time.Millisecond * 400
+	if e.Type().EncodeEntity() == "minecraft:arrow" {
+		duration = time.Second
+	}
+	w.ScheduleBlockUpdate(pos, duration)
+}
+
+// ScheduledTick stops the target from emitting power.
+func (t Target) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if t.Power != 0 {
+		t.Power = 0
+		w.SetBlock(pos, t, nil)
+	}
+}
+
+// RedstonePower returns the power that the target is currently emitting to all of its sides.
+func (t Target) RedstonePower(cube.Pos, cube.Face, *world.World) int {
+	return t.Power
+}
+
+// BreakInfo ...
+func (t Target) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, hoeEffective, oneOf(Target{}))
+}
+
+// DecodeNBT ...
+func (t Target) DecodeNBT(data map[string]any) any {
+	t.Power = int(nbtconv.Int32(data, "power"))
+	return t
+}
+
+// EncodeNBT ...
+func (t Target) EncodeNBT() map[string]any {
+	return map[string]any{"id": "Target", "power": int32(t.Power)}
+}
+
+// EncodeItem ...
+func (Target) EncodeItem() (name string, meta int16) {
+	return "minecraft:target", 0
+}
+
+// EncodeBlock ...
+func (Target) EncodeBlock() (string, map[string]any) {
+	return "minecraft:target", nil
+}
@@ -99,6 +99,11 @@ type Config struct {
 	// may be added to the Server's worlds. If no entity types are registered,
 	// Entities will be set to entity.DefaultRegistry.
 	Entities world.EntityRegistry
+	// Spawns returns the world.SpawnEntries of the mobs that spawn naturally
+	// in a world.Biome of the Server's worlds. If nil, no mobs are spawned or
+	// despawned naturally. Set Spawns to entity.BiomeSpawns to spawn mobs like
+	// vanilla.
+	Spawns func(b world.Biome) []world.SpawnEntry
 
 	LegacyHeight bool
 }
@@ -24,6 +24,22 @@ type DeathAction struct{ action }
 // item in its hand being eaten.
 type EatAction struct{ action }
 
+// LoveAction is a world.EntityAction that makes an animal display heart particles, showing that it is in love.
+type LoveAction struct{ action }
+
+// EatGrassAction is a world.EntityAction that makes an entity, such as a sheep, display the animation of eating
+// grass.
+type EatGrassAction struct{ action }
+
+// TameAction is a world.EntityAction that makes an animal display the particles shown when a player attempts to
+// tame it: hearts if taming succeeded and smoke if it failed.
+type TameAction struct {
+	// Success specifies if the animal was tamed.
+	Success bool
+
+	action
+}
+
 // ArrowShakeAction makes an arrow entity display a shaking animation for the given duration.
 type ArrowShakeAction struct {
 	// Duration is the duration of the shake.
@@ -44,9 +60,19 @@ type PickedUpAction struct {
 // FireworkExplosionAction is a world.EntityAction that makes a Firework rocket display an explosion particle.
 type FireworkExplosionAction struct{ action }
 
+// FishingHookBubbleAction is a world.EntityAction that makes a fishing hook display bubbles, showing that a fish is
+// approaching it.
+type FishingHookBubbleAction struct{ action }
+
+// FishingHookBiteAction is a world.EntityAction that makes a fishing hook display the animation of a fish biting it.
+type FishingHookBiteAction struct{ action }
+
 // TotemUseAction is a world.EntityAction that displays the totem use particles and animation.
 type TotemUseAction struct{ action }
 
+// WakeUpAction is a world.EntityAction that makes a sleeping entity wake up and leave its bed.
+type WakeUpAction struct{ action }
+
 // action implements the Action interface. Structures in this package may embed it to gets its functionality
 // out of the box.
 type action struct{}
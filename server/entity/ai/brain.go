@@ -0,0 +1,165 @@
+package ai
+
+import (
+	"github.com/df-mc/dragonfly/server/entity/pathfind"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Mob is an entity that is controlled by a Brain. It is implemented by
+// entity.Mob.
+type Mob interface {
+	pathfind.Entity
+	// Teleport teleports the Mob to the position passed.
+	Teleport(pos mgl64.Vec3)
+	// Health and MaxHealth return the current and maximum health of the Mob.
+	Health() float64
+	MaxHealth() float64
+	// Dead checks if the Mob is dead.
+	Dead() bool
+	// EyeHeight returns the height of the eyes of the Mob.
+	EyeHeight() float64
+	// AttackEntity makes the Mob attack the entity passed. False is returned
+	// if the entity could not be attacked.
+	AttackEntity(e world.Entity) bool
+	// LastAttacker returns the entity that last attacked the Mob. False is
+	// returned if the Mob was not attacked recently.
+	LastAttacker() (world.Entity, bool)
+}
+
+// Brain controls the behaviour of a Mob. A Brain runs Sensors that fill its
+// Memory and a Selector that runs Goals based on their priority. The movement
+// of the Mob is controlled through a pathfind.Navigator.
+//
+// A Brain is typically created by the entity.MobBehaviour of the Mob when it
+// is first ticked, and ticked from its Tick method on every tick after that,
+// as done by entity.AnimalBehaviour and entity.MonsterBehaviour.
+type Brain struct {
+	mob Mob
+	nav *pathfind.Navigator
+
+	goals   *Selector
+	sensors []Sensor
+
+	memory map[Memory]world.Entity
+	target world.Entity
+	ticks  int64
+}
+
+// NewBrain creates a Brain for the Mob passed. The pathfind.Config passed is
+// used to find paths for the Mob.
+func NewBrain(m Mob, nav pathfind.Config) *Brain {
+	return &Brain{mob: m, nav: pathfind.NewNavigator(nav), goals: NewSelector(), memory: map[Memory]world.Entity{}}
+}
+
+// Mob returns the Mob controlled by the Brain.
+func (b *Brain) Mob() Mob {
+	return b.mob
+}
+
+// Navigator returns the pathfind.Navigator that moves the Mob.
+func (b *Brain) Navigator() *pathfind.Navigator {
+	return b.nav
+}
+
+// Goals returns the Selector that holds the Goals of the Brain.
+func (b *Brain) Goals() *Selector {
+	return b.goals
+}
+
+// AddGoal adds a Goal with a priority to the Brain. Goals with a lower
+// priority value are preferred over those with a higher one.
+func (b *Brain) AddGoal(priority int, g Goal) {
+	b.goals.Add(priority, g)
+}
+
+// AddSensor adds a Sensor to the Brain.
+func (b *Brain) AddSensor(s Sensor) {
+	b.sensors = append(b.sensors, s)
+}
+
+// Target returns the entity currently targeted by the Mob, for example to be
+// attacked. False is returned if the Mob has no target, or if the target is
+// no longer valid.
+func (b *Brain) Target() (world.Entity, bool) {
+	if b.target == nil || !b.valid(b.target) {
+		b.target = nil
+		return nil, false
+	}
+	if p, ok := b.target.(gameModeHolder); ok && !p.GameMode().AllowsTakingDamage() {
+		b.target = nil
+		return nil, false
+	}
+	if b.target.Position().Sub(b.mob.Position()).Len() > maxTargetDistance {
+		b.target = nil
+		return nil, false
+	}
+	return b.target, true
+}
+
+// maxTargetDistance is the distance to its target at which a Mob loses track
+// of it.
+const maxTargetDistance = 32
+
+// SetTarget sets the entity targeted by the Mob. Passing nil clears the
+// target.
+func (b *Brain) SetTarget(e world.Entity) {
+	b.target = e
+}
+
+// Remember stores the entity passed in the Memory of the Brain. Passing nil
+// forgets the entity currently stored.
+func (b *Brain) Remember(m Memory, e world.Entity) {
+	if e == nil {
+		delete(b.memory, m)
+		return
+	}
+	b.memory[m] = e
+}
+
+// Recall returns the entity stored in the Memory of the Brain. False is
+// returned if no entity is stored, or if the entity is no longer valid.
+func (b *Brain) Recall(m Memory) (world.Entity, bool) {
+	e, ok := b.memory[m]
+	if !ok {
+		return nil, false
+	}
+	if !b.valid(e) {
+		delete(b.memory, m)
+		return nil, false
+	}
+	return e, true
+}
+
+// Ticks returns the number of times that Tick was called on the Brain.
+func (b *Brain) Ticks() int64 {
+	return b.ticks
+}
+
+// Tick ticks the Brain. Sensors are run, the Goals of the Brain are updated
+// and the Mob is moved along its path.
+func (b *Brain) Tick() {
+	if b.mob.Dead() {
+		return
+	}
+	for _, s := range b.sensors {
+		if b.ticks%s.Interval() == 0 {
+			s.Sense(b)
+		}
+	}
+	b.goals.Tick(b)
+	b.nav.Tick(b.mob)
+	b.ticks++
+}
+
+// valid checks if an entity remembered or targeted by the Brain is still
+// valid: it must be in the same world as the Mob and must not be dead.
+func (b *Brain) valid(e world.Entity) bool {
+	if e.World() == nil || e.World() != b.mob.World() {
+		return false
+	}
+	if l, ok := e.(interface{ Dead() bool }); ok && l.Dead() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,6 @@
+// Package ai implements a goal based AI for mobs. A Brain runs Sensors that
+// fill its memory, selects Goals to run based on their priority and the
+// controls (Flags) they use, and moves the mob using a pathfind.Navigator.
+// New mobs are assembled from the reusable Goals in this package, such as
+// Wander, LookAtPlayer and MeleeAttack.
+package ai
@@ -0,0 +1,58 @@
+package ai
+
+import (
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+)
+
+// Flee is a Goal that makes the Mob run away in panic after it was attacked or
+// while it is on fire. The Goal requires an AttackerSensor.
+type Flee struct {
+	// Speed is the multiplier of the speed of the Mob while fleeing. If 0, a
+	// Speed of 1.25 is used.
+	Speed float64
+
+	target cube.Pos
+}
+
+// Flags ...
+func (g *Flee) Flags() Flag {
+	return FlagMove
+}
+
+// CanStart ...
+func (g *Flee) CanStart(b *Brain) bool {
+	attacker, attacked := b.Recall(MemoryAttacker)
+	if f, ok := b.Mob().(interface{ OnFireDuration() time.Duration }); !attacked && (!ok || f.OnFireDuration() <= 0) {
+		return false
+	}
+	pos, ok := randomPosition(b, 5, 4, func(pos cube.Pos) bool {
+		if !attacked {
+			return true
+		}
+		// Only move to positions further away from the attacker.
+		from := attacker.Position()
+		return pos.Vec3Centre().Sub(from).LenSqr() > b.Mob().Position().Sub(from).LenSqr()
+	})
+	g.target = pos
+	return ok
+}
+
+// CanContinue ...
+func (g *Flee) CanContinue(b *Brain) bool {
+	return b.Navigator().Navigating()
+}
+
+// Start ...
+func (g *Flee) Start(b *Brain) {
+	b.Navigator().NavigateTo(g.target, orDefault(g.Speed, 1.25))
+}
+
+// Stop ...
+func (g *Flee) Stop(b *Brain) {
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *Flee) Tick(*Brain) {}
@@ -0,0 +1,59 @@
+package ai
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Float is a Goal that makes the Mob swim up when it is in water, so that it
+// floats on the surface of the water instead of sinking and drowning.
+type Float struct{}
+
+// Flags ...
+func (*Float) Flags() Flag {
+	return FlagJump
+}
+
+// CanStart ...
+func (*Float) CanStart(b *Brain) bool {
+	return inWater(b.Mob())
+}
+
+// CanContinue ...
+func (*Float) CanContinue(b *Brain) bool {
+	return inWater(b.Mob())
+}
+
+// Start ...
+func (*Float) Start(*Brain) {}
+
+// Stop ...
+func (*Float) Stop(*Brain) {}
+
+// Tick ...
+func (*Float) Tick(b *Brain) {
+	if rand.Float64() < 0.8 {
+		m := b.Mob()
+		vel := m.Velocity()
+		vel[1] = math.Max(vel[1], 0.08)
+		m.SetVelocity(vel)
+	}
+}
+
+// inWater checks if the Mob passed is in water deep enough for it to float.
+func inWater(m Mob) bool {
+	w := m.World()
+	if w == nil {
+		return false
+	}
+	l, ok := w.Liquid(cube.PosFromVec3(m.Position().Add(mgl64.Vec3{0, math.Min(m.EyeHeight(), 0.4)})))
+	if !ok {
+		return false
+	}
+	_, ok = l.(block.Water)
+	return ok
+}
@@ -0,0 +1,110 @@
+package ai
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Owned is a Mob that has an owner, such as a tamed animal.
+type Owned interface {
+	// Owner returns the owner of the Mob. False is returned if the Mob has no
+	// owner, or if the owner is not currently online.
+	Owner() (world.Entity, bool)
+}
+
+// FollowOwner is a Goal that makes the Mob follow its owner when it gets too
+// far away. If the owner is very far away, the Mob teleports to it. The Mob
+// must implement Owned.
+type FollowOwner struct {
+	// Speed is the multiplier of the speed of the Mob while following its
+	// owner. If 0, a Speed of 1 is used.
+	Speed float64
+	// StartDistance is the distance to the owner at which the Mob starts
+	// following it. If 0, a StartDistance of 10 is used.
+	StartDistance float64
+	// StopDistance is the distance to the owner at which the Mob stops
+	// following it. If 0, a StopDistance of 2 is used.
+	StopDistance float64
+	// TeleportDistance is the distance to the owner at which the Mob
+	// teleports to it. If 0, a TeleportDistance of 12 is used.
+	TeleportDistance float64
+
+	repath int
+}
+
+// Flags ...
+func (g *FollowOwner) Flags() Flag {
+	return FlagMove | FlagLook
+}
+
+// CanStart ...
+func (g *FollowOwner) CanStart(b *Brain) bool {
+	owner, ok := g.owner(b)
+	return ok && owner.Position().Sub(b.Mob().Position()).Len() > orDefault(g.StartDistance, 10)
+}
+
+// CanContinue ...
+func (g *FollowOwner) CanContinue(b *Brain) bool {
+	owner, ok := g.owner(b)
+	return ok && owner.Position().Sub(b.Mob().Position()).Len() > orDefault(g.StopDistance, 2)
+}
+
+// Start ...
+func (g *FollowOwner) Start(*Brain) {
+	g.repath = 0
+}
+
+// Stop ...
+func (g *FollowOwner) Stop(b *Brain) {
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *FollowOwner) Tick(b *Brain) {
+	owner, ok := g.owner(b)
+	if !ok {
+		return
+	}
+	m := b.Mob()
+	lookAt(m, eyePosition(owner))
+	if g.repath--; g.repath > 0 {
+		return
+	}
+	g.repath = 10
+	if owner.Position().Sub(m.Position()).Len() >= orDefault(g.TeleportDistance, 12) {
+		g.teleport(b, owner)
+		return
+	}
+	b.Navigator().NavigateTo(cube.PosFromVec3(owner.Position()), orDefault(g.Speed, 1))
+}
+
+// teleport attempts to teleport the Mob to a position near its owner.
+func (g *FollowOwner) teleport(b *Brain, owner world.Entity) {
+	w, origin := owner.World(), cube.PosFromVec3(owner.Position())
+	for i := 0; i < 10; i++ {
+		pos := origin.Add(cube.Pos{rand.Intn(7) - 3, rand.Intn(3) - 1, rand.Intn(7) - 3})
+		if pos[0] == origin[0] && pos[2] == origin[2] {
+			// Don't teleport into the owner.
+			continue
+		}
+		if b.Navigator().Config().Standable(w, pos) {
+			b.Navigator().Stop()
+			b.Mob().Teleport(pos.Vec3Middle())
+			return
+		}
+	}
+}
+
+// owner returns the owner of the Mob if it is in the same world as the Mob.
+func (g *FollowOwner) owner(b *Brain) (world.Entity, bool) {
+	owner, ok := ownerOf(b)
+	if !ok {
+		return nil, false
+	}
+	if p, ok := owner.(gameModeHolder); ok && !p.GameMode().Visible() {
+		return nil, false
+	}
+	return owner, true
+}
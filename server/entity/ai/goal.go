@@ -0,0 +1,190 @@
+package ai
+
+import (
+	"math"
+	"slices"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Flag is a bitset of the controls that a Goal uses. Two Goals that share a
+// Flag are not able to run at the same time.
+type Flag uint8
+
+const (
+	// FlagMove is set for Goals that move the Mob.
+	FlagMove Flag = 1 << iota
+	// FlagLook is set for Goals that change the direction that the Mob looks
+	// in.
+	FlagLook
+	// FlagJump is set for Goals that make the Mob jump or swim up.
+	FlagJump
+	// FlagAttack is set for Goals that make the Mob attack its target.
+	FlagAttack
+	// FlagTarget is set for Goals that select the target of the Mob.
+	FlagTarget
+)
+
+// Goal is a single behaviour of a Mob, such as wandering around or attacking
+// a target. Goals are run by a Selector.
+type Goal interface {
+	// Flags returns the controls used by the Goal.
+	Flags() Flag
+	// CanStart checks if the Goal should start running.
+	CanStart(b *Brain) bool
+	// CanContinue checks if the Goal should keep running after it was
+	// started.
+	CanContinue(b *Brain) bool
+	// Start is called when the Goal starts running.
+	Start(b *Brain)
+	// Stop is called when the Goal stops running, either because it could no
+	// longer continue or because it was interrupted by a Goal with a higher
+	// priority.
+	Stop(b *Brain)
+	// Tick is called every tick that the Goal is running.
+	Tick(b *Brain)
+}
+
+// Selector runs the Goals of a Brain. Every tick, the Goals with the highest
+// priority that are able to start are run, as long as no other Goal with a
+// higher priority is running that uses the same Flags.
+type Selector struct {
+	goals   []*prioritisedGoal
+	running map[Flag]*prioritisedGoal
+}
+
+// prioritisedGoal is a Goal added to a Selector with a priority.
+type prioritisedGoal struct {
+	Goal
+	priority int
+	running  bool
+}
+
+// NewSelector returns an empty Selector.
+func NewSelector() *Selector {
+	return &Selector{running: map[Flag]*prioritisedGoal{}}
+}
+
+// Add adds a Goal with a priority to the Selector. Goals with a lower
+// priority value are preferred over those with a higher one.
+func (s *Selector) Add(priority int, g Goal) {
+	s.goals = append(s.goals, &prioritisedGoal{Goal: g, priority: priority})
+	slices.SortStableFunc(s.goals, func(a, b *prioritisedGoal) int {
+		return a.priority - b.priority
+	})
+}
+
+// Running returns all Goals that are currently running.
+func (s *Selector) Running() []Goal {
+	goals := make([]Goal, 0, len(s.goals))
+	for _, g := range s.goals {
+		if g.running {
+			goals = append(goals, g.Goal)
+		}
+	}
+	return goals
+}
+
+// Stop stops all Goals that are currently running.
+func (s *Selector) Stop(b *Brain) {
+	for _, g := range s.goals {
+		if g.running {
+			s.stop(b, g)
+		}
+	}
+}
+
+// Tick stops the Goals that can no longer continue, starts Goals that are
+// able to start and ticks all running Goals.
+func (s *Selector) Tick(b *Brain) {
+	for _, g := range s.goals {
+		if g.running && !g.CanContinue(b) {
+			s.stop(b, g)
+		}
+	}
+	for _, g := range s.goals {
+		if g.running || !s.available(g) || !g.CanStart(b) {
+			continue
+		}
+		s.start(b, g)
+	}
+	for _, g := range s.goals {
+		if g.running {
+			g.Tick(b)
+		}
+	}
+}
+
+// available checks if all Flags of the goal passed are either unused or used
+// by Goals with a lower priority.
+func (s *Selector) available(g *prioritisedGoal) bool {
+	for f := range s.flags(g) {
+		if other, ok := s.running[f]; ok && other.priority <= g.priority {
+			return false
+		}
+	}
+	return true
+}
+
+// start starts the goal passed, interrupting all Goals that use the same
+// Flags.
+func (s *Selector) start(b *Brain, g *prioritisedGoal) {
+	for f := range s.flags(g) {
+		if other, ok := s.running[f]; ok {
+			s.stop(b, other)
+		}
+		s.running[f] = g
+	}
+	g.running = true
+	g.Start(b)
+}
+
+// stop stops the goal passed and releases its Flags.
+func (s *Selector) stop(b *Brain, g *prioritisedGoal) {
+	for f := range s.flags(g) {
+		if s.running[f] == g {
+			delete(s.running, f)
+		}
+	}
+	g.running = false
+	g.Stop(b)
+}
+
+// flags returns a set of the individual Flags of the goal passed.
+func (s *Selector) flags(g *prioritisedGoal) map[Flag]struct{} {
+	flags := g.Flags()
+	m := make(map[Flag]struct{}, 5)
+	for f := FlagMove; f <= FlagTarget; f <<= 1 {
+		if flags&f != 0 {
+			m[f] = struct{}{}
+		}
+	}
+	return m
+}
+
+// lookAt rotates the Mob passed so that it looks at the position passed.
+func lookAt(m Mob, pos mgl64.Vec3) {
+	diff := pos.Sub(m.Position().Add(mgl64.Vec3{0, m.EyeHeight()}))
+	yaw := mgl64.RadToDeg(math.Atan2(-diff[0], diff[2]))
+	pitch := -mgl64.RadToDeg(math.Atan2(diff[1], math.Hypot(diff[0], diff[2])))
+	m.SetRotation(cube.Rotation{yaw, pitch})
+}
+
+// eyePosition returns the position of the eyes of the entity passed. If the
+// entity has no eyes, its position is returned.
+func eyePosition(e world.Entity) mgl64.Vec3 {
+	if eyed, ok := e.(interface{ EyeHeight() float64 }); ok {
+		return e.Position().Add(mgl64.Vec3{0, eyed.EyeHeight()})
+	}
+	return e.Position()
+}
+
+// orDefault returns v if it is not 0, or def otherwise.
+func orDefault[T int | float64](v, def T) T {
+	if v == 0 {
+		return def
+	}
+	return v
+}
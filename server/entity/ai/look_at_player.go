@@ -0,0 +1,64 @@
+package ai
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// LookAtPlayer is a Goal that makes the Mob look at the nearest player from
+// time to time. The Goal requires a NearestPlayerSensor.
+type LookAtPlayer struct {
+	// Range is the maximum distance to the player. If 0, a Range of 8 is used.
+	Range float64
+	// Chance is the chance, 1 in Chance, that the Mob starts looking at the
+	// nearest player every tick. If 0, a Chance of 50 is used.
+	Chance int
+
+	ticks int
+}
+
+// Flags ...
+func (g *LookAtPlayer) Flags() Flag {
+	return FlagLook
+}
+
+// CanStart ...
+func (g *LookAtPlayer) CanStart(b *Brain) bool {
+	if rand.Intn(orDefault(g.Chance, 50)) != 0 {
+		return false
+	}
+	_, ok := g.player(b)
+	return ok
+}
+
+// CanContinue ...
+func (g *LookAtPlayer) CanContinue(b *Brain) bool {
+	_, ok := g.player(b)
+	return ok && g.ticks > 0
+}
+
+// Start ...
+func (g *LookAtPlayer) Start(*Brain) {
+	g.ticks = 40 + rand.Intn(40)
+}
+
+// Stop ...
+func (g *LookAtPlayer) Stop(*Brain) {}
+
+// Tick ...
+func (g *LookAtPlayer) Tick(b *Brain) {
+	if p, ok := g.player(b); ok {
+		lookAt(b.Mob(), eyePosition(p))
+	}
+	g.ticks--
+}
+
+// player returns the nearest player if it is within the range of the Goal.
+func (g *LookAtPlayer) player(b *Brain) (world.Entity, bool) {
+	p, ok := b.Recall(MemoryNearestPlayer)
+	if !ok || p.Position().Sub(b.Mob().Position()).Len() > orDefault(g.Range, 8) {
+		return nil, false
+	}
+	return p, true
+}
@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// MeleeAttack is a Goal that makes the Mob move towards its target and attack
+// it once it is close enough. The target of the Mob is selected by Goals such
+// as TargetNearestPlayer and TargetAttacker.
+type MeleeAttack struct {
+	// Speed is the multiplier of the speed of the Mob while moving towards
+	// its target. If 0, a Speed of 1 is used.
+	Speed float64
+	// Cooldown is the number of ticks between two attacks. If 0, a Cooldown
+	// of 20 ticks is used.
+	Cooldown int
+
+	cooldown, repath int
+}
+
+// Flags ...
+func (g *MeleeAttack) Flags() Flag {
+	return FlagMove | FlagLook | FlagAttack
+}
+
+// CanStart ...
+func (g *MeleeAttack) CanStart(b *Brain) bool {
+	_, ok := b.Target()
+	return ok
+}
+
+// CanContinue ...
+func (g *MeleeAttack) CanContinue(b *Brain) bool {
+	_, ok := b.Target()
+	return ok
+}
+
+// Start ...
+func (g *MeleeAttack) Start(*Brain) {
+	g.repath = 0
+}
+
+// Stop ...
+func (g *MeleeAttack) Stop(b *Brain) {
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *MeleeAttack) Tick(b *Brain) {
+	target, ok := b.Target()
+	if !ok {
+		return
+	}
+	m := b.Mob()
+	lookAt(m, eyePosition(target))
+
+	if g.repath--; g.repath <= 0 {
+		// The target keeps moving, so the path needs to be updated
+		// regularly.
+		g.repath = 10
+		b.Navigator().NavigateTo(cube.PosFromVec3(target.Position()), orDefault(g.Speed, 1))
+	}
+	if g.cooldown = max(g.cooldown-1, 0); g.cooldown > 0 {
+		return
+	}
+	if target.Position().Sub(m.Position()).LenSqr() <= reachSqr(m, target) {
+		m.AttackEntity(target)
+		g.cooldown = orDefault(g.Cooldown, 20)
+	}
+}
+
+// reachSqr returns the squared distance within which the Mob passed is able to
+// attack the target passed.
+func reachSqr(m Mob, target world.Entity) float64 {
+	w := m.Type().BBox(m).Width() * 2
+	return w*w + target.Type().BBox(target).Width()
+}
@@ -0,0 +1,125 @@
+package ai
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Memory is a slot in the memory of a Brain that holds an entity. Memories
+// are filled by Sensors and used by Goals.
+type Memory int
+
+const (
+	// MemoryNearestPlayer holds the nearest player that the Mob is able to
+	// see. It is filled by NearestPlayerSensor.
+	MemoryNearestPlayer Memory = iota
+	// MemoryNearestItem holds the nearest item entity that the Mob is
+	// interested in. It is filled by NearestItemSensor.
+	MemoryNearestItem
+	// MemoryAttacker holds the entity that last attacked the Mob. It is
+	// filled by AttackerSensor.
+	MemoryAttacker
+)
+
+// Sensor senses the surroundings of a Mob and stores the results in the
+// Memory of a Brain.
+type Sensor interface {
+	// Interval returns the number of ticks between two calls to Sense.
+	Interval() int64
+	// Sense senses the surroundings of the Mob of the Brain passed.
+	Sense(b *Brain)
+}
+
+// NearestPlayerSensor is a Sensor that remembers the nearest player within a
+// range of the Mob in MemoryNearestPlayer. Players that are not visible or
+// cannot be damaged, such as players in spectator mode, are ignored.
+type NearestPlayerSensor struct {
+	// Range is the maximum distance to the player. If 0, a Range of 16 is
+	// used.
+	Range float64
+}
+
+// Interval ...
+func (NearestPlayerSensor) Interval() int64 {
+	return 10
+}
+
+// Sense ...
+func (s NearestPlayerSensor) Sense(b *Brain) {
+	b.Remember(MemoryNearestPlayer, nearest(b, s.Range, func(e world.Entity) bool {
+		p, ok := e.(gameModeHolder)
+		return ok && p.GameMode().Visible() && p.GameMode().AllowsTakingDamage()
+	}))
+}
+
+// NearestItemSensor is a Sensor that remembers the nearest item entity within
+// a range of the Mob in MemoryNearestItem.
+type NearestItemSensor struct {
+	// Range is the maximum distance to the item. If 0, a Range of 16 is used.
+	Range float64
+	// Item returns the item stack held by the entity passed and true if the
+	// entity is an item entity that the Mob is interested in.
+	Item func(e world.Entity) (item.Stack, bool)
+}
+
+// Interval ...
+func (NearestItemSensor) Interval() int64 {
+	return 10
+}
+
+// Sense ...
+func (s NearestItemSensor) Sense(b *Brain) {
+	b.Remember(MemoryNearestItem, nearest(b, s.Range, func(e world.Entity) bool {
+		_, ok := s.Item(e)
+		return ok
+	}))
+}
+
+// AttackerSensor is a Sensor that remembers the entity that last attacked the
+// Mob in MemoryAttacker.
+type AttackerSensor struct{}
+
+// Interval ...
+func (AttackerSensor) Interval() int64 {
+	return 1
+}
+
+// Sense ...
+func (AttackerSensor) Sense(b *Brain) {
+	attacker, _ := b.Mob().LastAttacker()
+	b.Remember(MemoryAttacker, attacker)
+}
+
+// gameModeHolder is an entity that has a game mode, such as a player.
+type gameModeHolder interface {
+	GameMode() world.GameMode
+}
+
+// nearest returns the entity nearest to the Mob of the Brain passed within
+// the range passed for which the filter passed returns true. If no such
+// entity exists, nil is returned.
+func nearest(b *Brain, r float64, filter func(e world.Entity) bool) world.Entity {
+	if r == 0 {
+		r = 16
+	}
+	m := b.Mob()
+	w, pos := m.World(), m.Position()
+	if w == nil {
+		return nil
+	}
+	var (
+		found world.Entity
+		dist  = r * r
+	)
+	box := cube.Box(pos[0]-r, pos[1]-r, pos[2]-r, pos[0]+r, pos[1]+r, pos[2]+r)
+	for _, e := range w.EntitiesWithin(box, nil) {
+		if e == world.Entity(m) || !filter(e) {
+			continue
+		}
+		if d := e.Position().Sub(pos).LenSqr(); d <= dist {
+			found, dist = e, d
+		}
+	}
+	return found
+}
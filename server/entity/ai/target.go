@@ -0,0 +1,243 @@
+package ai
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// TargetAttacker is a Goal that makes the Mob target the entity that last
+// attacked it. The Goal requires an AttackerSensor.
+type TargetAttacker struct {
+	// Filter is an optional function that must return true for the attacker
+	// passed to be targeted.
+	Filter func(e world.Entity) bool
+}
+
+// Flags ...
+func (*TargetAttacker) Flags() Flag {
+	return FlagTarget
+}
+
+// CanStart ...
+func (g *TargetAttacker) CanStart(b *Brain) bool {
+	attacker, ok := b.Recall(MemoryAttacker)
+	if !ok || (g.Filter != nil && !g.Filter(attacker)) {
+		return false
+	}
+	target, ok := b.Target()
+	return !ok || target != attacker
+}
+
+// CanContinue ...
+func (*TargetAttacker) CanContinue(*Brain) bool {
+	return false
+}
+
+// Start ...
+func (*TargetAttacker) Start(b *Brain) {
+	attacker, _ := b.Recall(MemoryAttacker)
+	b.SetTarget(attacker)
+}
+
+// Stop ...
+func (*TargetAttacker) Stop(*Brain) {}
+
+// Tick ...
+func (*TargetAttacker) Tick(*Brain) {}
+
+// TargetNearestPlayer is a Goal that makes the Mob target the nearest player
+// if it has no target yet. If the Mob has a CanSee(world.Entity) bool method,
+// only players that the Mob is able to see are targeted. The Goal requires a
+// NearestPlayerSensor.
+type TargetNearestPlayer struct {
+	// Range is the maximum distance to the player. If 0, a Range of 16 is
+	// used.
+	Range float64
+	// Condition is an optional function that must return true for the Mob to
+	// start targeting players, for example only at night.
+	Condition func(b *Brain) bool
+}
+
+// Flags ...
+func (*TargetNearestPlayer) Flags() Flag {
+	return FlagTarget
+}
+
+// CanStart ...
+func (g *TargetNearestPlayer) CanStart(b *Brain) bool {
+	if _, ok := b.Target(); ok {
+		return false
+	}
+	if g.Condition != nil && !g.Condition(b) {
+		return false
+	}
+	p, ok := b.Recall(MemoryNearestPlayer)
+	if !ok || p.Position().Sub(b.Mob().Position()).Len() > orDefault(g.Range, 16) {
+		return false
+	}
+	if s, ok := b.Mob().(interface{ CanSee(e world.Entity) bool }); ok && !s.CanSee(p) {
+		return false
+	}
+	return true
+}
+
+// CanContinue ...
+func (*TargetNearestPlayer) CanContinue(*Brain) bool {
+	return false
+}
+
+// Start ...
+func (*TargetNearestPlayer) Start(b *Brain) {
+	p, _ := b.Recall(MemoryNearestPlayer)
+	b.SetTarget(p)
+}
+
+// Stop ...
+func (*TargetNearestPlayer) Stop(*Brain) {}
+
+// Tick ...
+func (*TargetNearestPlayer) Tick(*Brain) {}
+
+// DefendOwner is a Goal that makes the Mob target the entity that last
+// attacked its owner. The Mob must implement Owned and its owner must have a
+// LastAttacker() (world.Entity, bool) method, like players.
+type DefendOwner struct {
+	// Condition is an optional function that must return true for the Mob to
+	// defend its owner, for example only if it is not sitting.
+	Condition func(b *Brain) bool
+	// Filter is an optional function that must return true for the attacker
+	// passed to be targeted.
+	Filter func(e world.Entity) bool
+}
+
+// Flags ...
+func (*DefendOwner) Flags() Flag {
+	return FlagTarget
+}
+
+// CanStart ...
+func (g *DefendOwner) CanStart(b *Brain) bool {
+	_, ok := g.attacker(b)
+	return ok
+}
+
+// CanContinue ...
+func (*DefendOwner) CanContinue(*Brain) bool {
+	return false
+}
+
+// Start ...
+func (g *DefendOwner) Start(b *Brain) {
+	attacker, _ := g.attacker(b)
+	b.SetTarget(attacker)
+}
+
+// Stop ...
+func (*DefendOwner) Stop(*Brain) {}
+
+// Tick ...
+func (*DefendOwner) Tick(*Brain) {}
+
+// attacker returns the entity that last attacked the owner of the Mob, if it
+// should be targeted.
+func (g *DefendOwner) attacker(b *Brain) (world.Entity, bool) {
+	if g.Condition != nil && !g.Condition(b) {
+		return nil, false
+	}
+	owner, ok := ownerOf(b)
+	if !ok {
+		return nil, false
+	}
+	a, ok := owner.(interface{ LastAttacker() (world.Entity, bool) })
+	if !ok {
+		return nil, false
+	}
+	return ownerCombatant(b, g.Filter, a.LastAttacker)
+}
+
+// AttackOwnerTarget is a Goal that makes the Mob target the entity that its
+// owner last attacked. The Mob must implement Owned and its owner must have a
+// LastAttacked() (world.Entity, bool) method, like players.
+type AttackOwnerTarget struct {
+	// Condition is an optional function that must return true for the Mob to
+	// attack the target of its owner, for example only if it is not sitting.
+	Condition func(b *Brain) bool
+	// Filter is an optional function that must return true for the entity
+	// passed to be targeted.
+	Filter func(e world.Entity) bool
+}
+
+// Flags ...
+func (*AttackOwnerTarget) Flags() Flag {
+	return FlagTarget
+}
+
+// CanStart ...
+func (g *AttackOwnerTarget) CanStart(b *Brain) bool {
+	_, ok := g.target(b)
+	return ok
+}
+
+// CanContinue ...
+func (*AttackOwnerTarget) CanContinue(*Brain) bool {
+	return false
+}
+
+// Start ...
+func (g *AttackOwnerTarget) Start(b *Brain) {
+	target, _ := g.target(b)
+	b.SetTarget(target)
+}
+
+// Stop ...
+func (*AttackOwnerTarget) Stop(*Brain) {}
+
+// Tick ...
+func (*AttackOwnerTarget) Tick(*Brain) {}
+
+// target returns the entity that the owner of the Mob last attacked, if it
+// should be targeted.
+func (g *AttackOwnerTarget) target(b *Brain) (world.Entity, bool) {
+	if g.Condition != nil && !g.Condition(b) {
+		return nil, false
+	}
+	owner, ok := ownerOf(b)
+	if !ok {
+		return nil, false
+	}
+	a, ok := owner.(interface{ LastAttacked() (world.Entity, bool) })
+	if !ok {
+		return nil, false
+	}
+	return ownerCombatant(b, g.Filter, a.LastAttacked)
+}
+
+// ownerCombatant returns the entity returned by the function passed if the
+// Mob should target it: the entity must be valid, must not be the Mob itself
+// or its owner and must not already be targeted.
+func ownerCombatant(b *Brain, filter func(e world.Entity) bool, f func() (world.Entity, bool)) (world.Entity, bool) {
+	e, ok := f()
+	if !ok || e == world.Entity(b.Mob()) || !b.valid(e) || (filter != nil && !filter(e)) {
+		return nil, false
+	}
+	if owner, _ := ownerOf(b); e == owner {
+		return nil, false
+	}
+	if target, ok := b.Target(); ok && target == e {
+		return nil, false
+	}
+	return e, true
+}
+
+// ownerOf returns the owner of the Mob of the Brain passed if the Mob
+// implements Owned and its owner is in the same world.
+func ownerOf(b *Brain) (world.Entity, bool) {
+	o, ok := b.Mob().(Owned)
+	if !ok {
+		return nil, false
+	}
+	owner, ok := o.Owner()
+	if !ok || !b.valid(owner) {
+		return nil, false
+	}
+	return owner, true
+}
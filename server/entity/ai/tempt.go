@@ -0,0 +1,85 @@
+package ai
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Tempt is a Goal that makes the Mob follow the nearest player while the
+// player is holding an item that tempts the Mob. The Goal requires a
+// NearestPlayerSensor.
+type Tempt struct {
+	// Speed is the multiplier of the speed of the Mob while following the
+	// player. If 0, a Speed of 1 is used.
+	Speed float64
+	// Items returns true if the item stack passed tempts the Mob.
+	Items func(s item.Stack) bool
+	// Range is the maximum distance to the player. If 0, a Range of 10 is
+	// used.
+	Range float64
+
+	cooldown int
+}
+
+// Flags ...
+func (g *Tempt) Flags() Flag {
+	return FlagMove | FlagLook
+}
+
+// CanStart ...
+func (g *Tempt) CanStart(b *Brain) bool {
+	if g.cooldown > 0 {
+		g.cooldown--
+		return false
+	}
+	_, ok := g.player(b)
+	return ok
+}
+
+// CanContinue ...
+func (g *Tempt) CanContinue(b *Brain) bool {
+	_, ok := g.player(b)
+	return ok
+}
+
+// Start ...
+func (g *Tempt) Start(*Brain) {}
+
+// Stop ...
+func (g *Tempt) Stop(b *Brain) {
+	g.cooldown = 100
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *Tempt) Tick(b *Brain) {
+	p, ok := g.player(b)
+	if !ok {
+		return
+	}
+	m := b.Mob()
+	lookAt(m, eyePosition(p))
+	if p.Position().Sub(m.Position()).Len() < 2.5 {
+		b.Navigator().Stop()
+		return
+	}
+	b.Navigator().NavigateTo(cube.PosFromVec3(p.Position()), orDefault(g.Speed, 1))
+}
+
+// player returns the nearest player if it is within the range of the Goal and
+// holds an item that tempts the Mob.
+func (g *Tempt) player(b *Brain) (world.Entity, bool) {
+	p, ok := b.Recall(MemoryNearestPlayer)
+	if !ok || p.Position().Sub(b.Mob().Position()).Len() > orDefault(g.Range, 10) {
+		return nil, false
+	}
+	h, ok := p.(interface {
+		HeldItems() (mainHand, offHand item.Stack)
+	})
+	if !ok || g.Items == nil {
+		return nil, false
+	}
+	mainHand, offHand := h.HeldItems()
+	return p, g.Items(mainHand) || g.Items(offHand)
+}
@@ -0,0 +1,79 @@
+package ai
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+)
+
+// Wander is a Goal that makes the Mob walk to random positions near it from
+// time to time.
+type Wander struct {
+	// Speed is the multiplier of the speed of the Mob while wandering. If 0,
+	// a Speed of 1 is used.
+	Speed float64
+	// Chance is the chance, 1 in Chance, that the Mob starts wandering every
+	// tick. If 0, a Chance of 120 is used.
+	Chance int
+	// Range is the maximum horizontal distance that the Mob wanders from its
+	// position. If 0, a Range of 10 is used.
+	Range int
+
+	target cube.Pos
+}
+
+// Flags ...
+func (g *Wander) Flags() Flag {
+	return FlagMove
+}
+
+// CanStart ...
+func (g *Wander) CanStart(b *Brain) bool {
+	if b.Navigator().Navigating() || rand.Intn(orDefault(g.Chance, 120)) != 0 {
+		return false
+	}
+	pos, ok := randomPosition(b, orDefault(g.Range, 10), 7, nil)
+	g.target = pos
+	return ok
+}
+
+// CanContinue ...
+func (g *Wander) CanContinue(b *Brain) bool {
+	return b.Navigator().Navigating()
+}
+
+// Start ...
+func (g *Wander) Start(b *Brain) {
+	b.Navigator().NavigateTo(g.target, orDefault(g.Speed, 1))
+}
+
+// Stop ...
+func (g *Wander) Stop(b *Brain) {
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *Wander) Tick(*Brain) {}
+
+// randomPosition attempts to find a random position within a horizontal and
+// vertical range of the Mob of the Brain passed that the Mob is able to stand
+// at. If the filter passed is not nil, it must return true for the position
+// to be selected. False is returned if no position was found.
+func randomPosition(b *Brain, r, vr int, filter func(pos cube.Pos) bool) (cube.Pos, bool) {
+	m := b.Mob()
+	w := m.World()
+	if w == nil {
+		return cube.Pos{}, false
+	}
+	conf, origin := b.Navigator().Config(), cube.PosFromVec3(m.Position())
+	for i := 0; i < 10; i++ {
+		pos := origin.Add(cube.Pos{rand.Intn(r*2+1) - r, rand.Intn(vr*2+1) - vr, rand.Intn(r*2+1) - r})
+		if pos.OutOfBounds(w.Range()) || (filter != nil && !filter(pos)) {
+			continue
+		}
+		if conf.Standable(w, pos) {
+			return pos, true
+		}
+	}
+	return cube.Pos{}, false
+}
@@ -0,0 +1,389 @@
+package entity
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/entity/pathfind"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// AnimalBehaviourConfig holds optional parameters for an AnimalBehaviour.
+type AnimalBehaviourConfig struct {
+	// Food returns true if the item stack passed is food that the animal
+	// eats. Animals are tempted by their food and may be bred by feeding it
+	// to them.
+	Food func(s item.Stack) bool
+	// Offspring creates the baby born when the parent Mob breeds with its
+	// partner. The baby is added to the world by the AnimalBehaviour.
+	Offspring func(parent, partner *Mob) *Mob
+	// Goals is called when the ai.Brain of the animal is created and may be
+	// used to add goals specific to the animal, in addition to the default
+	// goals of animals.
+	Goals func(m *Mob, b *ai.Brain)
+}
+
+// New creates an AnimalBehaviour using the parameters in conf.
+func (conf AnimalBehaviourConfig) New() *AnimalBehaviour {
+	return &AnimalBehaviour{conf: conf}
+}
+
+// AnimalBehaviour implements the behaviour shared by passive animals such as
+// cows and pigs. Animals wander around, panic when attacked, are tempted by
+// their food and breed when fed. Babies grow up after 20 minutes.
+type AnimalBehaviour struct {
+	conf  AnimalBehaviourConfig
+	brain *ai.Brain
+
+	mu sync.Mutex
+	// age is the age of the animal in ticks. A negative age means the animal
+	// is a baby that grows up once the age reaches 0. A positive age is the
+	// number of ticks before the animal is able to breed again.
+	age  int64
+	love int64
+}
+
+// babyAge is the age in ticks that babies are born with.
+const babyAge = -24000
+
+// Baby checks if the animal is a baby.
+func (a *AnimalBehaviour) Baby() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.age < 0
+}
+
+// Scale returns the scale of the animal: 0.5 for babies and 1 for adults.
+func (a *AnimalBehaviour) Scale() float64 {
+	if a.Baby() {
+		return 0.5
+	}
+	return 1
+}
+
+// InLove checks if the animal was fed and is looking for a partner to breed
+// with.
+func (a *AnimalBehaviour) InLove() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.love > 0
+}
+
+// Brain returns the ai.Brain of the animal. Nil is returned if the animal
+// was not ticked yet.
+func (a *AnimalBehaviour) Brain() *ai.Brain {
+	return a.brain
+}
+
+// Tick ticks the AI of the animal and makes babies grow up.
+func (a *AnimalBehaviour) Tick(m *Mob) {
+	if a.brain == nil {
+		a.brain = a.newBrain(m)
+	}
+	var grown, loveEnded, hearts bool
+	a.mu.Lock()
+	switch {
+	case a.age < 0:
+		a.age++
+		grown = a.age == 0
+	case a.age > 0:
+		a.age--
+	}
+	if a.love > 0 {
+		a.love--
+		loveEnded, hearts = a.love == 0, a.love%10 == 0
+	}
+	a.mu.Unlock()
+
+	if grown || loveEnded {
+		m.updateState()
+	} else if hearts {
+		for _, v := range m.viewers() {
+			v.ViewEntityAction(m, LoveAction{})
+		}
+	}
+	a.brain.Tick()
+}
+
+// Interact feeds the food held by the user to the animal. Adults fall in love
+// with each other when fed and babies grow up faster.
+func (a *AnimalBehaviour) Interact(m *Mob, user item.User) bool {
+	held, left := user.HeldItems()
+	if a.conf.Food == nil || !a.conf.Food(held) {
+		return false
+	}
+	a.mu.Lock()
+	baby, ready, ticks := a.age < 0, a.age == 0 && a.love == 0, -a.age/10
+	if ready {
+		a.love = 600
+	}
+	a.mu.Unlock()
+
+	switch {
+	case baby:
+		a.grow(m, ticks)
+	case ready:
+		m.updateState()
+	default:
+		return false
+	}
+	if !creative(user) {
+		user.SetHeldItems(held.Grow(-1), left)
+	}
+	return true
+}
+
+// grow makes a baby animal grow up by the number of ticks passed.
+func (a *AnimalBehaviour) grow(m *Mob, ticks int64) {
+	a.mu.Lock()
+	baby := a.age < 0
+	if baby {
+		a.age = min(a.age+ticks, 0)
+	}
+	grown := baby && a.age == 0
+	a.mu.Unlock()
+
+	if grown {
+		m.updateState()
+	}
+}
+
+// setAge sets the age of the animal in ticks, and stops the animal from being
+// in love.
+func (a *AnimalBehaviour) setAge(age int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.age, a.love = age, 0
+}
+
+// breed makes the animal breed with its partner, adding a baby to the world.
+func (a *AnimalBehaviour) breed(m, partner *Mob) {
+	other, ok := animalOf(partner)
+	if !ok || a.conf.Offspring == nil {
+		return
+	}
+	a.setAge(6000)
+	other.setAge(6000)
+	m.updateState()
+	partner.updateState()
+
+	w, pos := m.World(), m.Position()
+	baby := a.conf.Offspring(m, partner)
+	if b, ok := animalOf(baby); ok {
+		b.setAge(babyAge)
+	}
+	w.AddEntity(baby)
+	for _, orb := range NewExperienceOrbs(pos, rand.Intn(7)+1) {
+		orb.SetVelocity(mgl64.Vec3{(rand.Float64()*0.2 - 0.1) * 2, rand.Float64() * 0.4, (rand.Float64()*0.2 - 0.1) * 2})
+		w.AddEntity(orb)
+	}
+}
+
+// newBrain creates the ai.Brain of the animal with the goals shared by all
+// animals.
+func (a *AnimalBehaviour) newBrain(m *Mob) *ai.Brain {
+	bb := m.Type().BBox(m)
+	b := ai.NewBrain(m, pathfind.Config{Width: bb.Width(), Height: bb.Height()})
+	b.AddSensor(ai.NearestPlayerSensor{})
+	b.AddSensor(ai.AttackerSensor{})
+
+	b.AddGoal(0, &ai.Float{})
+	b.AddGoal(1, &ai.Flee{Speed: 1.25})
+	b.AddGoal(2, &breedGoal{a: a})
+	if a.conf.Food != nil {
+		b.AddGoal(3, &ai.Tempt{Speed: 1.1, Items: a.conf.Food})
+	}
+	b.AddGoal(6, &ai.Wander{})
+	b.AddGoal(7, &ai.LookAtPlayer{})
+	if a.conf.Goals != nil {
+		a.conf.Goals(m, b)
+	}
+	return b
+}
+
+// decodeNBT decodes the properties of the animal from the NBT map passed.
+func (a *AnimalBehaviour) decodeNBT(data map[string]any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.age = int64(nbtconv.Int32(data, "Age"))
+	a.love = int64(nbtconv.Int32(data, "InLove"))
+}
+
+// encodeNBT encodes the properties of the animal into the NBT map passed.
+func (a *AnimalBehaviour) encodeNBT(data map[string]any) map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	data["Age"] = int32(a.age)
+	data["InLove"] = int32(a.love)
+	data["IsBaby"] = a.age < 0
+	return data
+}
+
+// animalBehaviour returns the AnimalBehaviour itself. It allows finding the
+// AnimalBehaviour of animals with a MobBehaviour that embeds it.
+func (a *AnimalBehaviour) animalBehaviour() *AnimalBehaviour {
+	return a
+}
+
+// animalOf returns the AnimalBehaviour of the Mob passed. False is returned
+// if the Mob is not an animal.
+func animalOf(m *Mob) (*AnimalBehaviour, bool) {
+	if a, ok := m.Behaviour().(interface{ animalBehaviour() *AnimalBehaviour }); ok {
+		return a.animalBehaviour(), true
+	}
+	return nil, false
+}
+
+// animalBBox scales the bounding box passed down if the entity passed is a
+// baby animal.
+func animalBBox(e world.Entity, width, height float64) cube.BBox {
+	if m, ok := e.(*Mob); ok {
+		if a, ok := animalOf(m); ok && a.Baby() {
+			width, height = width/2, height/2
+		}
+	}
+	return cube.Box(-width/2, 0, -width/2, width/2, height, width/2)
+}
+
+// decodeAnimal creates an animal using the function passed and decodes its
+// properties from the NBT map passed.
+func decodeAnimal(create func(pos mgl64.Vec3) *Mob, data map[string]any) *Mob {
+	m := decodeMob(create(nbtconv.Vec3(data, "Pos")), data)
+	if a, ok := animalOf(m); ok {
+		a.decodeNBT(data)
+	}
+	return m
+}
+
+// encodeAnimal encodes the properties of the animal passed into an NBT map.
+func encodeAnimal(m *Mob) map[string]any {
+	data := encodeMob(m)
+	if a, ok := animalOf(m); ok {
+		a.encodeNBT(data)
+	}
+	return data
+}
+
+// animalExperience returns the experience dropped by an animal when it is
+// killed. Babies do not drop experience.
+func animalExperience(m *Mob) int {
+	if a, ok := animalOf(m); ok && a.Baby() {
+		return 0
+	}
+	return rand.Intn(3) + 1
+}
+
+// creative checks if the user passed is in a game mode with a creative
+// inventory, in which items held are not used up.
+func creative(user item.User) bool {
+	g, ok := user.(interface{ GameMode() world.GameMode })
+	return ok && g.GameMode().CreativeInventory()
+}
+
+// wheatFood checks if the item stack passed is wheat, the food of cows and
+// sheep.
+func wheatFood(s item.Stack) bool {
+	_, ok := s.Item().(item.Wheat)
+	return ok
+}
+
+// fillBucket replaces one of the items held in the main hand of the user with
+// the filled bucket passed. If the user holds more than one item, the filled
+// bucket is added to its inventory or dropped if the inventory is full.
+func fillBucket(user item.User, held, left, filled item.Stack) {
+	if creative(user) {
+		if inv, ok := user.(interface{ Inventory() *inventory.Inventory }); ok {
+			_, _ = inv.Inventory().AddItem(filled)
+		}
+		return
+	}
+	if held.Count() == 1 {
+		user.SetHeldItems(filled, left)
+		return
+	}
+	user.SetHeldItems(held.Grow(-1), left)
+	if inv, ok := user.(interface{ Inventory() *inventory.Inventory }); ok {
+		if _, err := inv.Inventory().AddItem(filled); err == nil {
+			return
+		}
+	}
+	user.World().AddEntity(NewItem(filled, user.Position()))
+}
+
+// breedGoal is an ai.Goal that makes an animal in love move to a partner of
+// the same type that is also in love and breed with it.
+type breedGoal struct {
+	a       *AnimalBehaviour
+	partner *Mob
+	ticks   int
+}
+
+// Flags ...
+func (g *breedGoal) Flags() ai.Flag {
+	return ai.FlagMove | ai.FlagLook
+}
+
+// CanStart ...
+func (g *breedGoal) CanStart(b *ai.Brain) bool {
+	if !g.a.InLove() {
+		return false
+	}
+	g.partner = g.findPartner(b.Mob().(*Mob))
+	return g.partner != nil
+}
+
+// CanContinue ...
+func (g *breedGoal) CanContinue(b *ai.Brain) bool {
+	other, ok := animalOf(g.partner)
+	return g.a.InLove() && ok && other.InLove() && !g.partner.Dead() && g.ticks < 60
+}
+
+// Start ...
+func (g *breedGoal) Start(*ai.Brain) {
+	g.ticks = 0
+}
+
+// Stop ...
+func (g *breedGoal) Stop(b *ai.Brain) {
+	g.partner = nil
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *breedGoal) Tick(b *ai.Brain) {
+	m := b.Mob().(*Mob)
+	m.LookAt(EyePosition(g.partner))
+	b.Navigator().NavigateTo(cube.PosFromVec3(g.partner.Position()), 1)
+	if g.ticks++; g.ticks >= 60 && m.Position().Sub(g.partner.Position()).Len() < 3 {
+		g.a.breed(m, g.partner)
+	}
+}
+
+// findPartner finds the nearest animal of the same type as the Mob passed
+// that is in love.
+func (g *breedGoal) findPartner(m *Mob) *Mob {
+	w, pos := m.World(), m.Position()
+	var (
+		partner *Mob
+		dist    = 64.0
+	)
+	for _, e := range w.EntitiesWithin(cube.Box(pos[0]-8, pos[1]-8, pos[2]-8, pos[0]+8, pos[1]+8, pos[2]+8), nil) {
+		other, ok := e.(*Mob)
+		if !ok || other == m || other.Type() != m.Type() || other.Dead() {
+			continue
+		}
+		if a, ok := animalOf(other); !ok || !a.InLove() {
+			continue
+		}
+		if d := other.Position().Sub(pos).LenSqr(); d < dist {
+			partner, dist = other, d
+		}
+	}
+	return partner
+}
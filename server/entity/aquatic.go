@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// AquaticBehaviourConfig holds settings that influence the way an
+// AquaticBehaviour operates. AquaticBehaviourConfig.New() may be called to
+// create a new behaviour with this config.
+type AquaticBehaviourConfig struct {
+	// Bucket is the entity type that the mob is held as in a bucket of water
+	// after being captured by a player. If nil, the mob cannot be captured.
+	Bucket item.BucketableEntityType
+	// Flops specifies if the mob flops around while on land, like fish do.
+	Flops bool
+}
+
+// New creates an AquaticBehaviour using the settings provided in conf.
+func (conf AquaticBehaviourConfig) New() *AquaticBehaviour {
+	return &AquaticBehaviour{conf: conf}
+}
+
+// AquaticBehaviour implements the behaviour of mobs that live in water, such
+// as fish and squids. Aquatic mobs swim around randomly, flee when attacked
+// and, if the mob flops, flop around helplessly on land. Mobs using an
+// AquaticBehaviour should be created with MobConfig.Aquatic set to true.
+type AquaticBehaviour struct {
+	conf AquaticBehaviourConfig
+
+	target     mgl64.Vec3
+	swimTicks  int
+	fromBucket bool
+}
+
+// FromBucket checks if the mob was released from a bucket. Mobs released
+// from a bucket are never despawned.
+func (a *AquaticBehaviour) FromBucket() bool {
+	return a.fromBucket
+}
+
+// Persistent returns true if the mob was released from a bucket.
+func (a *AquaticBehaviour) Persistent() bool {
+	return a.fromBucket
+}
+
+// Tick makes the mob swim towards a random position in the water around it,
+// or flop around if it is on land.
+func (a *AquaticBehaviour) Tick(m *Mob) {
+	w, pos := m.World(), m.Position()
+	if !m.insideOfLiquid(w, pos) {
+		a.swimTicks = 0
+		if a.conf.Flops && m.OnGround() {
+			m.SetVelocity(mgl64.Vec3{(rand.Float64()*2 - 1) * 0.05, 0.4, (rand.Float64()*2 - 1) * 0.05})
+			w.PlaySound(pos, sound.Flop{Entity: m.Type()})
+		}
+		return
+	}
+	speed := m.Speed()
+	if attacker, ok := m.LastAttacker(); ok {
+		// Swim away from the attacker at increased speed.
+		if away := pos.Sub(attacker.Position()); away.Len() > 0 {
+			a.target, a.swimTicks = pos.Add(away.Normalize().Mul(6)), 20
+		}
+		speed *= 2
+	}
+	if a.swimTicks--; a.swimTicks <= 0 || a.target.Sub(pos).Len() < 1 {
+		if !a.findTarget(m, w, pos) {
+			return
+		}
+	}
+	diff := a.target.Sub(pos)
+	if diff.Len() < 0.1 {
+		return
+	}
+	vel := m.Velocity()
+	m.SetVelocity(vel.Add(diff.Normalize().Mul(speed).Sub(vel).Mul(0.2)))
+	m.LookAt(a.target)
+}
+
+// findTarget attempts to find a random position in water near pos for the
+// Mob to swim to. False is returned if no such position could be found.
+func (a *AquaticBehaviour) findTarget(m *Mob, w *world.World, pos mgl64.Vec3) bool {
+	for i := 0; i < 10; i++ {
+		target := pos.Add(mgl64.Vec3{rand.Float64()*20 - 10, rand.Float64()*8 - 4, rand.Float64()*20 - 10})
+		if m.insideOfLiquid(w, target) {
+			a.target, a.swimTicks = target, 40+rand.Intn(60)
+			return true
+		}
+	}
+	a.swimTicks = 20
+	return false
+}
+
+// Interact captures the mob in a bucket of water held by the user, if the
+// mob may be captured.
+func (a *AquaticBehaviour) Interact(m *Mob, user item.User) bool {
+	held, left := user.HeldItems()
+	b, ok := held.Item().(item.Bucket)
+	if !ok || a.conf.Bucket == nil || m.Dead() {
+		return false
+	}
+	liq, ok := b.Content.Liquid()
+	if _, water := liq.(block.Water); !ok || !water {
+		return false
+	}
+	if _, full := b.Content.Entity(); full {
+		return false
+	}
+	m.World().PlaySound(m.Position(), sound.BucketFillFish{})
+	fillBucket(user, held, left, item.NewStack(item.Bucket{Content: item.EntityBucketContent(liq, a.conf.Bucket)}, 1))
+	_ = m.Close()
+	return true
+}
+
+// aquaticOf returns the AquaticBehaviour of the Mob passed, if it has one.
+func aquaticOf(m *Mob) (*AquaticBehaviour, bool) {
+	a, ok := m.Behaviour().(interface{ aquatic() *AquaticBehaviour })
+	if !ok {
+		return nil, false
+	}
+	return a.aquatic(), true
+}
+
+// aquatic returns the AquaticBehaviour itself, so that behaviours embedding
+// it can be recognised by aquaticOf.
+func (a *AquaticBehaviour) aquatic() *AquaticBehaviour {
+	return a
+}
+
+// releasedFromBucket marks the Mob passed as released from a bucket and
+// returns it.
+func releasedFromBucket(m *Mob) world.Entity {
+	if a, ok := aquaticOf(m); ok {
+		a.fromBucket = true
+	}
+	return m
+}
+
+// decodeAquatic creates an aquatic mob using the function passed and decodes
+// its properties from the NBT map passed.
+func decodeAquatic(create func(pos mgl64.Vec3) *Mob, data map[string]any) *Mob {
+	m := decodeMob(create(nbtconv.Vec3(data, "Pos")), data)
+	if a, ok := aquaticOf(m); ok {
+		a.fromBucket = nbtconv.Bool(data, "FromBucket")
+	}
+	return m
+}
+
+// encodeAquatic encodes the properties of the aquatic mob passed into an NBT
+// map.
+func encodeAquatic(m *Mob) map[string]any {
+	data := encodeMob(m)
+	if a, ok := aquaticOf(m); ok {
+		data["FromBucket"] = a.fromBucket
+	}
+	return data
+}
+
+// fishDrops returns a function that returns the drops of a fish: the item
+// returned by the function passed, and occasionally bone meal. The function
+// is passed whether the fish died while on fire.
+func fishDrops(fish func(cooked bool) world.Item) func(m *Mob, src world.DamageSource) []item.Stack {
+	return func(m *Mob, src world.DamageSource) []item.Stack {
+		drops := []item.Stack{item.NewStack(fish(m.OnFireDuration() > 0), 1+lootingBonus(src))}
+		if rand.Intn(20) == 0 {
+			drops = append(drops, item.NewStack(item.BoneMeal{}, 1))
+		}
+		return drops
+	}
+}
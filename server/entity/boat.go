@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewBoat creates a boat entity made of the wood type passed. Boats float on
+// water and may be ridden by up to two players.
+func NewBoat(pos mgl64.Vec3, yaw float64, wood block.WoodType) *Ent {
+	e := Config{Behaviour: BoatBehaviourConfig{Wood: wood}.New()}.New(BoatType{}, pos)
+	e.rot = cube.Rotation{yaw, 0}
+	return e
+}
+
+// NewChestBoat creates a boat carrying a chest, made of the wood type passed.
+// Chest boats have an inventory of 27 slots and may be ridden by one player.
+func NewChestBoat(pos mgl64.Vec3, yaw float64, wood block.WoodType) *Ent {
+	e := Config{Behaviour: BoatBehaviourConfig{Wood: wood, Chest: true}.New()}.New(ChestBoatType{}, pos)
+	e.rot = cube.Rotation{yaw, 0}
+	return e
+}
+
+// BoatType is a world.EntityType implementation for Boat.
+type BoatType struct{}
+
+func (BoatType) EncodeEntity() string   { return "minecraft:boat" }
+func (BoatType) NetworkOffset() float64 { return 0.375 }
+func (BoatType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.7, 0, -0.7, 0.7, 0.455, 0.7)
+}
+
+func (BoatType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeBoat(NewBoat(nbtconv.Vec3(m, "Pos"), 0, boatWood(nbtconv.Int32(m, "Variant"))), m)
+}
+
+func (BoatType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeBoat(e.(*Ent))
+}
+
+// ChestBoatType is a world.EntityType implementation for ChestBoat.
+type ChestBoatType struct{}
+
+func (ChestBoatType) EncodeEntity() string   { return "minecraft:chest_boat" }
+func (ChestBoatType) NetworkOffset() float64 { return 0.375 }
+func (ChestBoatType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.7, 0, -0.7, 0.7, 0.455, 0.7)
+}
+
+func (ChestBoatType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeBoat(NewChestBoat(nbtconv.Vec3(m, "Pos"), 0, boatWood(nbtconv.Int32(m, "Variant"))), m)
+}
+
+func (ChestBoatType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeBoat(e.(*Ent))
+}
+
+// decodeBoat decodes the properties shared by all boats from the NBT map
+// passed into the boat e.
+func decodeBoat(e *Ent, m map[string]any) *Ent {
+	e.vel = nbtconv.Vec3(m, "Motion")
+	e.rot = nbtconv.Rotation(m)
+	if uniqueID, ok := m["UniqueID"].(int64); ok {
+		e.uniqueID = uniqueID
+	}
+	if inv := e.Behaviour().(*BoatBehaviour).Inventory(); inv != nil {
+		nbtconv.InvFromNBT(inv, nbtconv.Slice[any](m, "Items"))
+	}
+	return e
+}
+
+// encodeBoat encodes the properties shared by all boats into an NBT map.
+func encodeBoat(e *Ent) map[string]any {
+	b := e.Behaviour().(*BoatBehaviour)
+	yaw, pitch := e.Rotation().Elem()
+	m := map[string]any{
+		"UniqueID": e.uniqueID,
+		"Pos":      nbtconv.Vec3ToFloat32Slice(e.Position()),
+		"Motion":   nbtconv.Vec3ToFloat32Slice(e.Velocity()),
+		"Yaw":      float32(yaw),
+		"Pitch":    float32(pitch),
+		"Variant":  b.Variant(),
+	}
+	if inv := b.Inventory(); inv != nil {
+		m["Items"] = nbtconv.InvToNBT(inv)
+	}
+	return m
+}
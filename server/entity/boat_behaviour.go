@@ -0,0 +1,287 @@
+package entity
+
+import (
+	"math"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// BoatBehaviourConfig holds optional parameters for a BoatBehaviour.
+type BoatBehaviourConfig struct {
+	// Wood is the type of wood that the boat is made of.
+	Wood block.WoodType
+	// Chest specifies if the boat carries a chest. Boats carrying a chest have
+	// an inventory of 27 slots and only have room for a single passenger.
+	Chest bool
+}
+
+// New creates a BoatBehaviour using the parameters in conf.
+func (conf BoatBehaviourConfig) New() *BoatBehaviour {
+	seats, size := []mgl64.Vec3{{0.2, 1.02, 0}, {-0.6, 1.02, 0}}, 0
+	if conf.Chest {
+		seats, size = seats[:1], 27
+	}
+	return &BoatBehaviour{
+		Seats:     NewSeats(seats...),
+		container: newContainer(size),
+		conf:      conf,
+		mc:        &MovementComputer{},
+	}
+}
+
+const (
+	// boatMaxDamage is the amount of damage that a boat is able to take before
+	// being destroyed.
+	boatMaxDamage = 40
+	// boatBuoyancy is the upwards acceleration in blocks/tick² that a boat
+	// gets for being fully submerged in water.
+	boatBuoyancy = 0.06153846
+)
+
+// BoatBehaviour implements the behaviour of boats. Boats float on water, slide
+// over ice and may be steered by the player in the front seat.
+type BoatBehaviour struct {
+	*Seats
+	*container
+
+	conf BoatBehaviourConfig
+	mc   *MovementComputer
+
+	mu       sync.Mutex
+	input    RiderInput
+	deltaYaw float64
+	damage   float64
+	close    bool
+}
+
+// Wood returns the type of wood that the boat is made of.
+func (b *BoatBehaviour) Wood() block.WoodType {
+	return b.conf.Wood
+}
+
+// Variant returns the variant of the boat, which depends on its type of wood.
+func (b *BoatBehaviour) Variant() int32 {
+	return boatVariant(b.conf.Wood)
+}
+
+// Steer changes the movement input that the boat is steered with. It is
+// called for the driver of the boat every tick.
+func (b *BoatBehaviour) Steer(_ *Ent, _ Rider, input RiderInput) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.input = input
+}
+
+// SneakInteractable returns true if the boat carries a chest, so that its
+// inventory may be opened by sneaking users.
+func (b *BoatBehaviour) SneakInteractable() bool {
+	return b.Inventory() != nil
+}
+
+// Interact makes the user passed ride the boat. If the boat carries a chest,
+// its inventory is opened instead if the user is sneaking.
+func (b *BoatBehaviour) Interact(e *Ent, user item.User) bool {
+	sneaking := false
+	if s, ok := user.(interface{ Sneaking() bool }); ok {
+		sneaking = s.Sneaking()
+	}
+	if b.Inventory() != nil && sneaking {
+		if opener, ok := user.(interface{ OpenEntityContainer(e world.Entity) }); ok {
+			opener.OpenEntityContainer(e)
+			return true
+		}
+		return false
+	}
+	r, ok := user.(Rider)
+	if !ok || sneaking || b.Full() {
+		return false
+	}
+	if v, riding := r.RidingEntity(); riding && v == e {
+		return false
+	}
+	r.RideEntity(e)
+	return true
+}
+
+// Damage damages the boat. Once the boat has taken enough damage in a short
+// time, it breaks. Boats attacked by players in creative mode break
+// immediately without dropping any items.
+func (b *BoatBehaviour) Damage(e *Ent, damage float64, src world.DamageSource) bool {
+	if s, ok := src.(AttackDamageSource); ok {
+		if g, ok := s.Attacker.(interface{ GameMode() world.GameMode }); ok && g.GameMode().CreativeInventory() {
+			b.destroy(e, false)
+			return true
+		}
+	}
+	for _, v := range e.World().Viewers(e.Position()) {
+		v.ViewEntityAction(e, HurtAction{})
+	}
+	b.mu.Lock()
+	b.damage += damage * 10
+	destroyed := b.damage > boatMaxDamage
+	b.mu.Unlock()
+
+	if destroyed {
+		b.destroy(e, true)
+	}
+	return true
+}
+
+// Explode pushes the boat away from the explosion and damages it.
+func (b *BoatBehaviour) Explode(e *Ent, src mgl64.Vec3, impact float64, _ block.ExplosionConfig) {
+	if diff := e.Position().Sub(src); diff.Len() != 0 {
+		e.SetVelocity(e.Velocity().Add(diff.Normalize().Mul(impact)))
+	}
+	b.Damage(e, impact*10, ExplosionDamageSource{})
+}
+
+// destroy removes the boat, dismounting its passengers and dropping the boat
+// and the contents of its inventory if drops is true.
+func (b *BoatBehaviour) destroy(e *Ent, drops bool) {
+	b.mu.Lock()
+	if b.close {
+		b.mu.Unlock()
+		return
+	}
+	b.close = true
+	b.mu.Unlock()
+
+	b.DismountAll()
+	if !drops {
+		return
+	}
+	w, pos := e.World(), e.Position()
+	w.AddEntity(NewItem(item.NewStack(block.Boat{Wood: b.conf.Wood, Chest: b.conf.Chest}, 1), pos))
+	if inv := b.Inventory(); inv != nil {
+		for _, it := range inv.Clear() {
+			w.AddEntity(NewItem(it, pos))
+		}
+	}
+}
+
+// Tick moves the boat, making it float on water and steering it using the
+// input of its driver.
+func (b *BoatBehaviour) Tick(e *Ent) *Movement {
+	b.mu.Lock()
+	if b.close {
+		b.mu.Unlock()
+		_ = e.Close()
+		return nil
+	}
+	b.damage = math.Max(b.damage-1, 0)
+	if _, ok := b.Driver(); !ok {
+		b.input = RiderInput{}
+	}
+	input := b.input
+	b.mu.Unlock()
+
+	w := e.World()
+	e.mu.Lock()
+	pos, vel, rot := e.pos, e.vel, e.rot
+	e.mu.Unlock()
+
+	box := e.Type().BBox(e).Translate(pos)
+	level, inWater := boatWaterLevel(w, box)
+
+	momentum := 0.9
+	vel[1] -= 0.04
+	switch {
+	case inWater && level >= box.Max()[1]:
+		// The boat is submerged, so it rises to the surface.
+		vel[1] += 0.05
+		momentum = 0.45
+	case inWater:
+		vel[1] = (vel[1] + (level-pos[1])/box.Height()*boatBuoyancy) * 0.75
+	case b.mc.OnGround():
+		// The friction of the block below the boat is applied by the
+		// MovementComputer.
+		momentum = 1
+	}
+	vel[0] *= momentum
+	vel[2] *= momentum
+
+	b.mu.Lock()
+	b.deltaYaw = b.deltaYaw*momentum - input.Sideways
+	yaw := rot.Yaw() + b.deltaYaw
+	b.mu.Unlock()
+
+	var force float64
+	if input.Sideways != 0 && input.Forward == 0 {
+		force += 0.005
+	}
+	if input.Forward > 0 {
+		force += 0.04 * input.Forward
+	} else if input.Forward < 0 {
+		force -= 0.005
+	}
+	rad := mgl64.DegToRad(yaw)
+	vel = vel.Add(mgl64.Vec3{-math.Sin(rad) * force, 0, math.Cos(rad) * force})
+
+	m := b.mc.TickMovement(e, pos, vel, cube.Rotation{yaw, 0})
+	e.mu.Lock()
+	e.pos, e.vel, e.rot = m.pos, m.vel, m.rot
+	e.mu.Unlock()
+	return m
+}
+
+// boatWaterLevel returns the height of the highest water surface within the
+// columns of the box passed, provided the surface is above the bottom of the
+// box. If no such surface exists, false is returned.
+func boatWaterLevel(w *world.World, box cube.BBox) (float64, bool) {
+	min, max := box.Min(), box.Max()
+	level, found := 0.0, false
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+			for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+				l, ok := w.Liquid(cube.Pos{x, y, z})
+				if !ok {
+					continue
+				}
+				if _, water := l.(block.Water); !water {
+					continue
+				}
+				if h := float64(y) + float64(l.LiquidDepth())/9; h >= min[1] && h > level {
+					level, found = h, true
+				}
+			}
+		}
+	}
+	return level, found
+}
+
+// boatVariant returns the variant of a boat made of the wood type passed, as
+// it is sent over network.
+func boatVariant(wood block.WoodType) int32 {
+	switch wood {
+	case block.SpruceWood():
+		return 1
+	case block.BirchWood():
+		return 2
+	case block.JungleWood():
+		return 3
+	case block.AcaciaWood():
+		return 4
+	case block.DarkOakWood():
+		return 5
+	case block.Mangrove():
+		return 6
+	case block.Cherry():
+		return 8
+	}
+	return 0
+}
+
+// boatWood returns the wood type of a boat with the variant passed.
+func boatWood(variant int32) block.WoodType {
+	for _, wood := range block.BoatWoodTypes() {
+		if boatVariant(wood) == variant {
+			return wood
+		}
+	}
+	return block.OakWood()
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewCat creates a new untamed adult cat with a random variant at the position
+// passed. Cats are tamed by feeding them raw cod or salmon.
+func NewCat(pos mgl64.Vec3) *Mob {
+	return NewCatWithVariant(pos, int32(rand.Intn(catVariants)))
+}
+
+// NewCatWithVariant creates a new untamed adult cat with the variant passed at
+// the position passed. The variant ranges from 0 to 10 and decides the
+// texture of the cat.
+func NewCatWithVariant(pos mgl64.Vec3, variant int32) *Mob {
+	return MobConfig{
+		Category:   world.MobCategoryCreature,
+		MaxHealth:  10,
+		Speed:      0.12,
+		EyeHeight:  0.35,
+		Experience: animalExperience,
+		Drops:      catDrops,
+		Behaviour: &catBehaviour{variant: variant, TameableBehaviour: TameableBehaviourConfig{
+			TameItem:  catFood,
+			Tempted:   true,
+			Food:      catFood,
+			Offspring: catOffspring,
+		}.New()},
+	}.New(CatType{}, pos)
+}
+
+// catVariants is the number of variants that cats have.
+const catVariants = 11
+
+// catBehaviour is the MobBehaviour of cats.
+type catBehaviour struct {
+	*TameableBehaviour
+	variant int32
+}
+
+// Variant returns the variant of the cat, which decides its texture.
+func (c *catBehaviour) Variant() int32 {
+	return c.variant
+}
+
+// catFood checks if the item stack passed is raw cod or salmon, the food of
+// cats.
+func catFood(s item.Stack) bool {
+	switch it := s.Item().(type) {
+	case item.Cod:
+		return !it.Cooked
+	case item.Salmon:
+		return !it.Cooked
+	}
+	return false
+}
+
+// catOffspring returns a baby cat with the variant of one of its parents.
+func catOffspring(parent, partner *Mob) *Mob {
+	variant := parent.Behaviour().(*catBehaviour).variant
+	if rand.Intn(2) == 0 {
+		variant = partner.Behaviour().(*catBehaviour).variant
+	}
+	return NewCatWithVariant(parent.Position(), variant)
+}
+
+// catDrops returns the items dropped by a cat when it dies.
+func catDrops(m *Mob, src world.DamageSource) []item.Stack {
+	if c := m.Behaviour().(*catBehaviour); c.Baby() {
+		return nil
+	}
+	if n := rand.Intn(3) + lootingBonus(src); n > 0 {
+		return []item.Stack{item.NewStack(item.String{}, n)}
+	}
+	return nil
+}
+
+// CatType is a world.EntityType implementation for cats.
+type CatType struct{}
+
+func (CatType) EncodeEntity() string { return "minecraft:cat" }
+func (CatType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.6, 0.7)
+}
+
+func (CatType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeTameable(NewCat, data)
+	m.Behaviour().(*catBehaviour).variant = nbtconv.Int32(data, "Variant") % catVariants
+	return m
+}
+
+func (CatType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	data := encodeTameable(m)
+	data["Variant"] = m.Behaviour().(*catBehaviour).variant
+	return data
+}
@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewChicken creates a new adult chicken at the position passed. Chickens
+// lay an egg every 5 to 10 minutes, fall slowly and drop feathers and raw
+// chicken when killed.
+func NewChicken(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:   world.MobCategoryCreature,
+		MaxHealth:  4,
+		Speed:      0.1,
+		Experience: animalExperience,
+		Drops:      chickenDrops,
+		Behaviour: &chickenBehaviour{
+			AnimalBehaviour: AnimalBehaviourConfig{
+				Food:      chickenFood,
+				Offspring: func(parent, _ *Mob) *Mob { return NewChicken(parent.Position()) },
+			}.New(),
+			eggTime: nextEggTime(),
+		},
+	}.New(ChickenType{}, pos)
+}
+
+// chickenBehaviour is the MobBehaviour of chickens.
+type chickenBehaviour struct {
+	*AnimalBehaviour
+	eggTime int64
+}
+
+// Tick makes the chicken fall slowly and lay eggs.
+func (c *chickenBehaviour) Tick(m *Mob) {
+	c.AnimalBehaviour.Tick(m)
+
+	// Chickens flap their wings while falling, so they never take fall
+	// damage.
+	if vel := m.Velocity(); !m.OnGround() && vel[1] < 0 {
+		vel[1] *= 0.6
+		m.SetVelocity(vel)
+	}
+	m.ResetFallDistance()
+
+	if c.Baby() {
+		return
+	}
+	if c.eggTime--; c.eggTime <= 0 {
+		c.eggTime = nextEggTime()
+		w, pos := m.World(), m.Position()
+		w.PlaySound(pos, sound.Plop{})
+		w.AddEntity(NewItem(item.NewStack(item.Egg{}, 1), pos))
+	}
+}
+
+// nextEggTime returns the number of ticks before a chicken lays its next egg.
+func nextEggTime() int64 {
+	return 6000 + rand.Int63n(6000)
+}
+
+// chickenFood checks if the item stack passed is food that chickens eat:
+// seeds of any kind.
+func chickenFood(s item.Stack) bool {
+	switch s.Item().(type) {
+	case block.WheatSeeds, block.MelonSeeds, block.PumpkinSeeds, block.BeetrootSeeds:
+		return true
+	}
+	return false
+}
+
+// chickenDrops returns the items dropped by a chicken when it dies.
+func chickenDrops(m *Mob, src world.DamageSource) []item.Stack {
+	if a, ok := animalOf(m); ok && a.Baby() {
+		return nil
+	}
+	return []item.Stack{
+		item.NewStack(item.Feather{}, rand.Intn(3)+lootingBonus(src)),
+		item.NewStack(item.Chicken{Cooked: m.OnFireDuration() > 0}, 1+lootingBonus(src)),
+	}
+}
+
+// ChickenType is a world.EntityType implementation for chickens.
+type ChickenType struct{}
+
+func (ChickenType) EncodeEntity() string { return "minecraft:chicken" }
+func (ChickenType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.4, 0.7)
+}
+
+func (ChickenType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeAnimal(NewChicken, data)
+	if t, ok := data["EggLayTime"].(int32); ok {
+		m.Behaviour().(*chickenBehaviour).eggTime = int64(t)
+	}
+	return m
+}
+
+func (ChickenType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	data := encodeAnimal(m)
+	data["EggLayTime"] = int32(m.Behaviour().(*chickenBehaviour).eggTime)
+	return data
+}
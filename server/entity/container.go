@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+)
+
+// container holds the inventory of an entity that carries items, such as a
+// chest minecart, and the viewers that currently have the inventory opened.
+// The methods of a nil *container may be called safely.
+type container struct {
+	inv *inventory.Inventory
+
+	viewerMu sync.RWMutex
+	viewers  map[block.ContainerViewer]struct{}
+}
+
+// newContainer creates a container with an inventory of the size passed. If
+// size is 0, nil is returned.
+func newContainer(size int) *container {
+	if size <= 0 {
+		return nil
+	}
+	c := &container{viewers: make(map[block.ContainerViewer]struct{})}
+	c.inv = inventory.New(size, func(slot int, _, it item.Stack) {
+		c.viewerMu.RLock()
+		defer c.viewerMu.RUnlock()
+		for viewer := range c.viewers {
+			viewer.ViewSlotChange(slot, it)
+		}
+	})
+	return c
+}
+
+// Inventory returns the inventory of the entity. Nil is returned if the
+// entity does not have an inventory.
+func (c *container) Inventory() *inventory.Inventory {
+	if c == nil {
+		return nil
+	}
+	return c.inv
+}
+
+// AddViewer adds a viewer to the inventory of the entity, so that it is
+// updated whenever the inventory is changed.
+func (c *container) AddViewer(v block.ContainerViewer) {
+	if c == nil {
+		return
+	}
+	c.viewerMu.Lock()
+	defer c.viewerMu.Unlock()
+	c.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the inventory of the entity, so that
+// slot updates are no longer sent to it.
+func (c *container) RemoveViewer(v block.ContainerViewer) {
+	if c == nil {
+		return
+	}
+	c.viewerMu.Lock()
+	defer c.viewerMu.Unlock()
+	delete(c.viewers, v)
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewCow creates a new adult cow at the position passed. Cows drop leather
+// and beef when killed and may be milked using a bucket.
+func NewCow(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:   world.MobCategoryCreature,
+		MaxHealth:  10,
+		Speed:      0.1,
+		Experience: animalExperience,
+		Drops:      cowDrops,
+		Behaviour: &cowBehaviour{AnimalBehaviour: AnimalBehaviourConfig{
+			Food:      wheatFood,
+			Offspring: func(parent, _ *Mob) *Mob { return NewCow(parent.Position()) },
+		}.New()},
+	}.New(CowType{}, pos)
+}
+
+// cowBehaviour is the MobBehaviour of cows.
+type cowBehaviour struct {
+	*AnimalBehaviour
+}
+
+// Interact fills an empty bucket held by the user with milk. If the user does
+// not hold a bucket, the cow is fed the item held.
+func (c *cowBehaviour) Interact(m *Mob, user item.User) bool {
+	held, left := user.HeldItems()
+	if b, ok := held.Item().(item.Bucket); !ok || !b.Empty() || c.Baby() {
+		return c.AnimalBehaviour.Interact(m, user)
+	}
+	m.World().PlaySound(m.Position(), sound.MilkCow{})
+	fillBucket(user, held, left, item.NewStack(item.Bucket{Content: item.MilkBucketContent()}, 1))
+	return true
+}
+
+// cowDrops returns the items dropped by a cow when it dies.
+func cowDrops(m *Mob, src world.DamageSource) []item.Stack {
+	if a, ok := animalOf(m); ok && a.Baby() {
+		return nil
+	}
+	return []item.Stack{
+		item.NewStack(item.Leather{}, rand.Intn(3)+lootingBonus(src)),
+		item.NewStack(item.Beef{Cooked: m.OnFireDuration() > 0}, rand.Intn(3)+1+lootingBonus(src)),
+	}
+}
+
+// CowType is a world.EntityType implementation for cows.
+type CowType struct{}
+
+func (CowType) EncodeEntity() string { return "minecraft:cow" }
+func (CowType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.9, 1.4)
+}
+
+func (CowType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeAnimal(NewCow, data)
+}
+
+func (CowType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeAnimal(e.(*Mob))
+}
@@ -0,0 +1,234 @@
+package entity
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewCreeper creates a new creeper at the position passed. Creepers walk up to
+// players and explode once their fuse runs out.
+func NewCreeper(pos mgl64.Vec3) *Mob {
+	c := &creeperBehaviour{fuse: creeperFuse}
+	c.MonsterBehaviour = MonsterBehaviourConfig{
+		Goals: func(_ *Mob, b *ai.Brain) {
+			b.AddGoal(3, &creeperAttackGoal{c: c})
+		},
+	}.New()
+	return MobConfig{
+		Category:   world.MobCategoryMonster,
+		MaxHealth:  20,
+		Speed:      0.1,
+		Experience: monsterExperience,
+		Drops:      creeperDrops,
+		Behaviour:  c,
+	}.New(CreeperType{}, pos)
+}
+
+// creeperFuse is the number of ticks that a creeper swells before it
+// explodes.
+const creeperFuse = 30
+
+// creeperBehaviour is the MobBehaviour of creepers.
+type creeperBehaviour struct {
+	*MonsterBehaviour
+
+	fuseMu sync.Mutex
+	// fuse is the number of ticks left before the creeper explodes.
+	fuse int
+	// swelling is true if the creeper is currently swelling. If false, the
+	// fuse is reset slowly.
+	swelling bool
+	// ignited is true if the creeper was ignited using flint and steel. An
+	// ignited creeper keeps swelling until it explodes.
+	ignited bool
+}
+
+// Fuse returns the time left before the creeper explodes. If the creeper is
+// not swelling, -1 is returned.
+func (c *creeperBehaviour) Fuse() time.Duration {
+	c.fuseMu.Lock()
+	defer c.fuseMu.Unlock()
+	if !c.swelling && !c.ignited {
+		return -1
+	}
+	return time.Duration(c.fuse) * time.Second / 20
+}
+
+// Tick updates the fuse of the creeper and makes it explode once the fuse
+// runs out.
+func (c *creeperBehaviour) Tick(m *Mob) {
+	c.MonsterBehaviour.Tick(m)
+	if m.World() == nil || m.Dead() {
+		return
+	}
+	c.fuseMu.Lock()
+	if !c.swelling && !c.ignited {
+		c.fuse = min(c.fuse+1, creeperFuse)
+		c.fuseMu.Unlock()
+		return
+	}
+	ignite := c.fuse == creeperFuse
+	c.fuse--
+	explode := c.fuse <= 0
+	c.fuseMu.Unlock()
+
+	if ignite {
+		m.World().PlaySound(m.Position(), sound.Ignite{})
+	}
+	if explode {
+		c.explode(m)
+	}
+}
+
+// Interact ignites the creeper if the user is holding flint and steel.
+func (c *creeperBehaviour) Interact(m *Mob, user item.User) bool {
+	held, left := user.HeldItems()
+	if _, ok := held.Item().(item.FlintAndSteel); !ok {
+		return false
+	}
+	c.fuseMu.Lock()
+	ignited := c.ignited
+	c.ignited = true
+	c.fuseMu.Unlock()
+	if ignited {
+		return false
+	}
+	m.updateState()
+	if !creative(user) {
+		user.SetHeldItems(held.Damage(1), left)
+	}
+	return true
+}
+
+// setSwelling changes if the creeper is swelling.
+func (c *creeperBehaviour) setSwelling(m *Mob, swelling bool) {
+	c.fuseMu.Lock()
+	changed := c.swelling != swelling
+	c.swelling = swelling
+	c.fuseMu.Unlock()
+	if changed {
+		m.updateState()
+	}
+}
+
+// Swelling checks if the creeper is currently swelling.
+func (c *creeperBehaviour) Swelling() bool {
+	c.fuseMu.Lock()
+	defer c.fuseMu.Unlock()
+	return c.swelling
+}
+
+// Ignited checks if the creeper was ignited using flint and steel.
+func (c *creeperBehaviour) Ignited() bool {
+	c.fuseMu.Lock()
+	defer c.fuseMu.Unlock()
+	return c.ignited
+}
+
+// explode removes the creeper and creates an explosion at its position.
+func (c *creeperBehaviour) explode(m *Mob) {
+	w, pos := m.World(), m.Position()
+	_ = m.Close()
+	block.ExplosionConfig{Size: 3}.Explode(w, pos)
+}
+
+// creeperDrops returns the items dropped by a creeper when it dies.
+func creeperDrops(_ *Mob, src world.DamageSource) []item.Stack {
+	return []item.Stack{item.NewStack(item.Gunpowder{}, rand.Intn(3)+lootingBonus(src))}
+}
+
+// creeperAttackGoal is an ai.Goal that makes a creeper move towards its
+// target and start swelling once it is close enough.
+type creeperAttackGoal struct {
+	c      *creeperBehaviour
+	repath int
+}
+
+// Flags ...
+func (g *creeperAttackGoal) Flags() ai.Flag {
+	return ai.FlagMove | ai.FlagLook | ai.FlagAttack
+}
+
+// CanStart ...
+func (g *creeperAttackGoal) CanStart(b *ai.Brain) bool {
+	_, ok := b.Target()
+	return ok
+}
+
+// CanContinue ...
+func (g *creeperAttackGoal) CanContinue(b *ai.Brain) bool {
+	_, ok := b.Target()
+	return ok
+}
+
+// Start ...
+func (g *creeperAttackGoal) Start(*ai.Brain) {
+	g.repath = 0
+}
+
+// Stop ...
+func (g *creeperAttackGoal) Stop(b *ai.Brain) {
+	b.Navigator().Stop()
+	g.c.setSwelling(b.Mob().(*Mob), false)
+}
+
+// Tick ...
+func (g *creeperAttackGoal) Tick(b *ai.Brain) {
+	target, ok := b.Target()
+	if !ok {
+		return
+	}
+	m := b.Mob().(*Mob)
+	m.LookAt(EyePosition(target))
+
+	dist := target.Position().Sub(m.Position()).Len()
+	switch {
+	case dist < 3 && m.CanSee(target):
+		// The creeper stands still while swelling.
+		b.Navigator().Stop()
+		g.c.setSwelling(m, true)
+		return
+	case g.c.Swelling() && dist < 7 && m.CanSee(target):
+		g.c.setSwelling(m, true)
+	default:
+		g.c.setSwelling(m, false)
+	}
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		b.Navigator().NavigateTo(cube.PosFromVec3(target.Position()), 1)
+	}
+}
+
+// CreeperType is a world.EntityType implementation for creepers.
+type CreeperType struct{}
+
+func (CreeperType) EncodeEntity() string { return "minecraft:creeper" }
+func (CreeperType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.7, 0.3)
+}
+
+func (CreeperType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeMob(NewCreeper(nbtconv.Vec3(data, "Pos")), data)
+	c := m.Behaviour().(*creeperBehaviour)
+	c.fuseMu.Lock()
+	defer c.fuseMu.Unlock()
+	c.ignited = nbtconv.Bool(data, "ignited")
+	return m
+}
+
+func (CreeperType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	data := encodeMob(m)
+	data["ignited"] = m.Behaviour().(*creeperBehaviour).Ignited()
+	return data
+}
@@ -1,7 +1,10 @@
 package entity
 
 import (
+	"math/rand"
+
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/cube/trace"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/particle"
@@ -14,12 +17,29 @@ func NewEgg(pos mgl64.Vec3, owner world.Entity) *Ent {
 	return Config{Behaviour: eggConf.New(owner)}.New(EggType{}, pos)
 }
 
-// TODO: Spawn chicken(e) 12.5% of the time.
 var eggConf = ProjectileBehaviourConfig{
 	Gravity:       0.03,
 	Drag:          0.01,
 	Particle:      particle.EggSmash{},
 	ParticleCount: 6,
+	Hit:           spawnChicks,
+}
+
+// spawnChicks spawns a baby chicken at the target of the egg 12.5% of the
+// time. In rare cases, four chicks are spawned instead of one.
+func spawnChicks(e *Ent, target trace.Result) {
+	if rand.Intn(8) != 0 {
+		return
+	}
+	n := 1
+	if rand.Intn(32) == 0 {
+		n = 4
+	}
+	for i := 0; i < n; i++ {
+		chick := NewChicken(target.Position())
+		chick.Behaviour().(*chickenBehaviour).setAge(babyAge)
+		e.World().AddEntity(chick)
+	}
 }
 
 // EggType is a world.EntityType implementation for Egg.
@@ -7,6 +7,7 @@ import (
 
 	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 )
@@ -57,6 +58,97 @@ func (e *Ent) Explode(src mgl64.Vec3, impact float64, conf block.ExplosionConfig
 	}
 }
 
+// Interact propagates the interaction behaviour of the underlying Behaviour.
+// False is returned if the Behaviour cannot be interacted with.
+func (e *Ent) Interact(user item.User) bool {
+	if i, ok := e.conf.Behaviour.(interface {
+		Interact(e *Ent, user item.User) bool
+	}); ok {
+		return i.Interact(e, user)
+	}
+	return false
+}
+
+// SneakInteractable checks if the entity may be interacted with by a user that
+// is sneaking. False is returned if the underlying Behaviour does not allow it.
+func (e *Ent) SneakInteractable() bool {
+	if i, ok := e.conf.Behaviour.(interface{ SneakInteractable() bool }); ok {
+		return i.SneakInteractable()
+	}
+	return false
+}
+
+// Damage propagates the damage behaviour of the underlying Behaviour. False is
+// returned if the Behaviour cannot be damaged.
+func (e *Ent) Damage(damage float64, src world.DamageSource) bool {
+	if d, ok := e.conf.Behaviour.(interface {
+		Damage(e *Ent, damage float64, src world.DamageSource) bool
+	}); ok {
+		return d.Damage(e, damage, src)
+	}
+	return false
+}
+
+// AddPassenger seats the Rider passed on a free seat of the underlying
+// Behaviour. False is returned if the Behaviour cannot be ridden or if all of
+// its seats are taken.
+func (e *Ent) AddPassenger(r Rider) bool {
+	if s, ok := e.seats(); ok {
+		return s.add(r)
+	}
+	return false
+}
+
+// RemovePassenger removes the Rider passed from the seats of the underlying
+// Behaviour.
+func (e *Ent) RemovePassenger(r Rider) {
+	if s, ok := e.seats(); ok {
+		s.remove(r)
+	}
+}
+
+// Passengers returns the Riders seated on the underlying Behaviour.
+func (e *Ent) Passengers() []Rider {
+	if s, ok := e.seats(); ok {
+		return s.Passengers()
+	}
+	return nil
+}
+
+// SeatOffset returns the offset from the position of the entity at which the
+// Rider passed is seated.
+func (e *Ent) SeatOffset(r Rider) mgl64.Vec3 {
+	if s, ok := e.seats(); ok {
+		return s.Offset(r)
+	}
+	return mgl64.Vec3{}
+}
+
+// Steer propagates the movement input of the Rider passed to the underlying
+// Behaviour, provided the Rider is driving the entity.
+func (e *Ent) Steer(r Rider, input RiderInput) {
+	s, ok := e.seats()
+	if !ok {
+		return
+	}
+	if driver, ok := s.Driver(); !ok || driver != r {
+		return
+	}
+	if st, ok := e.conf.Behaviour.(interface {
+		Steer(e *Ent, r Rider, input RiderInput)
+	}); ok {
+		st.Steer(e, r, input)
+	}
+}
+
+// seats returns the Seats of the underlying Behaviour, if it may be ridden.
+func (e *Ent) seats() (*Seats, bool) {
+	if s, ok := e.conf.Behaviour.(interface{ Seats() *Seats }); ok {
+		return s.Seats(), true
+	}
+	return nil, false
+}
+
 // Type returns the world.EntityType passed to Config.New.
 func (e *Ent) Type() world.EntityType {
 	return e.t
@@ -172,6 +264,9 @@ func (e *Ent) Tick(w *world.World, current int64) {
 		return
 	}
 	e.SetOnFire(e.OnFireDuration() - time.Second/20)
+	if s, ok := e.seats(); ok {
+		s.validate(e)
+	}
 
 	if m := e.conf.Behaviour.Tick(e); m != nil {
 		m.Send()
@@ -0,0 +1,296 @@
+package entity
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewCod creates a new cod at the position passed. Cod swim around in oceans
+// and may be captured using a bucket of water.
+func NewCod(pos mgl64.Vec3) *Mob {
+	return newFish(CodType{}, pos, fishDrops(func(cooked bool) world.Item {
+		return item.Cod{Cooked: cooked}
+	}), nil)
+}
+
+// NewSalmon creates a new salmon at the position passed. Salmon swim around in
+// rivers and cold oceans and may be captured using a bucket of water.
+func NewSalmon(pos mgl64.Vec3) *Mob {
+	return newFish(SalmonType{}, pos, fishDrops(func(cooked bool) world.Item {
+		return item.Salmon{Cooked: cooked}
+	}), nil)
+}
+
+// NewTropicalFish creates a new tropical fish with a random shape, pattern and
+// colours at the position passed.
+func NewTropicalFish(pos mgl64.Vec3) *Mob {
+	colours := item.Colours()
+	return NewTropicalFishWithVariant(pos, int32(rand.Intn(2)), int32(rand.Intn(6)), colours[rand.Intn(len(colours))], colours[rand.Intn(len(colours))])
+}
+
+// NewTropicalFishWithVariant creates a new tropical fish at the position
+// passed. The shape of the fish is either 0 or 1 and the pattern ranges from 0
+// to 5. The base colour is the colour of the body of the fish, while the
+// pattern colour is the colour of the pattern on it.
+func NewTropicalFishWithVariant(pos mgl64.Vec3, shape, pattern int32, base, patternColour item.Colour) *Mob {
+	return newFish(TropicalFishType{}, pos, fishDrops(func(bool) world.Item {
+		return item.TropicalFish{}
+	}), &tropicalFishBehaviour{shape: shape, pattern: pattern, base: base, patternColour: patternColour})
+}
+
+// NewPufferfish creates a new pufferfish at the position passed. Pufferfish
+// puff up when other entities come close and poison entities touching them
+// while puffed up.
+func NewPufferfish(pos mgl64.Vec3) *Mob {
+	return newFish(PufferfishType{}, pos, fishDrops(func(bool) world.Item {
+		return item.Pufferfish{}
+	}), &pufferfishBehaviour{})
+}
+
+// newFish creates a new fish of the type passed. If behaviour is not nil, its
+// AquaticBehaviour is set and it is used as the behaviour of the fish.
+func newFish(t item.BucketableEntityType, pos mgl64.Vec3, drops func(m *Mob, src world.DamageSource) []item.Stack, behaviour interface {
+	MobBehaviour
+	setAquatic(a *AquaticBehaviour)
+}) *Mob {
+	a := AquaticBehaviourConfig{Bucket: t, Flops: true}.New()
+	var b MobBehaviour = a
+	if behaviour != nil {
+		behaviour.setAquatic(a)
+		b = behaviour
+	}
+	return MobConfig{
+		Category:   world.MobCategoryWater,
+		MaxHealth:  3,
+		Speed:      0.08,
+		Aquatic:    true,
+		Experience: animalExperience,
+		Drops:      drops,
+		Behaviour:  b,
+	}.New(t, pos)
+}
+
+// tropicalFishBehaviour is the MobBehaviour of tropical fish.
+type tropicalFishBehaviour struct {
+	*AquaticBehaviour
+	shape, pattern      int32
+	base, patternColour item.Colour
+}
+
+// setAquatic sets the AquaticBehaviour embedded in the tropical fish.
+func (t *tropicalFishBehaviour) setAquatic(a *AquaticBehaviour) {
+	t.AquaticBehaviour = a
+}
+
+// Variant returns the shape of the tropical fish.
+func (t *tropicalFishBehaviour) Variant() int32 {
+	return t.shape
+}
+
+// MarkVariant returns the pattern of the tropical fish.
+func (t *tropicalFishBehaviour) MarkVariant() int32 {
+	return t.pattern
+}
+
+// Colour returns the base colour of the tropical fish.
+func (t *tropicalFishBehaviour) Colour() item.Colour {
+	return t.base
+}
+
+// PatternColour returns the colour of the pattern of the tropical fish.
+func (t *tropicalFishBehaviour) PatternColour() item.Colour {
+	return t.patternColour
+}
+
+// pufferfishBehaviour is the MobBehaviour of pufferfish.
+type pufferfishBehaviour struct {
+	*AquaticBehaviour
+	puff, ticks int
+}
+
+// setAquatic sets the AquaticBehaviour embedded in the pufferfish.
+func (p *pufferfishBehaviour) setAquatic(a *AquaticBehaviour) {
+	p.AquaticBehaviour = a
+}
+
+// PuffState returns how far the pufferfish is puffed up, ranging from 0
+// (deflated) to 2 (fully puffed up).
+func (p *pufferfishBehaviour) PuffState() int {
+	return p.puff
+}
+
+// Tick puffs the pufferfish up when entities are close and poisons entities
+// touching it while it is puffed up.
+func (p *pufferfishBehaviour) Tick(m *Mob) {
+	p.AquaticBehaviour.Tick(m)
+
+	w, pos := m.World(), m.Position()
+	threatened := false
+	for _, e := range w.EntitiesWithin(cube.Box(-2, -2, -2, 2, 2, 2).Translate(pos), nil) {
+		if p.threat(m, e) {
+			threatened = true
+			break
+		}
+	}
+	before := p.puff
+	switch p.ticks++; {
+	case threatened && p.puff < 2 && p.ticks >= 10:
+		p.puff, p.ticks = p.puff+1, 0
+	case !threatened && p.puff > 0 && p.ticks >= 60:
+		p.puff, p.ticks = p.puff-1, 0
+	case threatened && p.puff == 2:
+		// Stay puffed up for as long as the pufferfish is threatened.
+		p.ticks = 0
+	}
+	if p.puff != before {
+		m.updateState()
+	}
+	if p.puff == 0 {
+		return
+	}
+	box := m.Type().BBox(m).Translate(pos)
+	for _, e := range w.EntitiesWithin(box.Grow(0.3), nil) {
+		l, ok := e.(Living)
+		if !ok || !p.threat(m, e) || !e.Type().BBox(e).Translate(e.Position()).IntersectsWith(box) {
+			continue
+		}
+		if _, vulnerable := l.Hurt(float64(1+p.puff), AttackDamageSource{Attacker: m}); vulnerable {
+			l.AddEffect(effect.New(effect.Poison{}, 1, time.Duration(p.puff)*3*time.Second))
+		}
+	}
+}
+
+// threat checks if the entity passed causes the pufferfish to puff up. Only
+// living entities that are not aquatic themselves and, in case of players,
+// may be attacked, are considered a threat.
+func (p *pufferfishBehaviour) threat(m *Mob, e world.Entity) bool {
+	if e == world.Entity(m) {
+		return false
+	}
+	if _, ok := e.(Living); !ok {
+		return false
+	}
+	if other, ok := e.(*Mob); ok && other.conf.Aquatic {
+		return false
+	}
+	if g, ok := e.(interface{ GameMode() world.GameMode }); ok && !g.GameMode().AllowsTakingDamage() {
+		return false
+	}
+	return true
+}
+
+// CodType is a world.EntityType implementation for cod.
+type CodType struct{}
+
+func (CodType) EncodeEntity() string { return "minecraft:cod" }
+func (CodType) BucketName() string   { return "cod" }
+func (CodType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.25, 0, -0.25, 0.25, 0.3, 0.25)
+}
+
+func (CodType) FromBucket(pos mgl64.Vec3) world.Entity {
+	return releasedFromBucket(NewCod(pos))
+}
+
+func (CodType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeAquatic(NewCod, data)
+}
+
+func (CodType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeAquatic(e.(*Mob))
+}
+
+// SalmonType is a world.EntityType implementation for salmon.
+type SalmonType struct{}
+
+func (SalmonType) EncodeEntity() string { return "minecraft:salmon" }
+func (SalmonType) BucketName() string   { return "salmon" }
+func (SalmonType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.35, 0, -0.35, 0.35, 0.4, 0.35)
+}
+
+func (SalmonType) FromBucket(pos mgl64.Vec3) world.Entity {
+	return releasedFromBucket(NewSalmon(pos))
+}
+
+func (SalmonType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeAquatic(NewSalmon, data)
+}
+
+func (SalmonType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeAquatic(e.(*Mob))
+}
+
+// TropicalFishType is a world.EntityType implementation for tropical fish.
+type TropicalFishType struct{}
+
+func (TropicalFishType) EncodeEntity() string { return "minecraft:tropicalfish" }
+func (TropicalFishType) BucketName() string   { return "tropical_fish" }
+func (TropicalFishType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.2, 0, -0.2, 0.2, 0.4, 0.2)
+}
+func (TropicalFishType) FromBucket(pos mgl64.Vec3) world.Entity {
+	return releasedFromBucket(NewTropicalFish(pos))
+}
+
+func (TropicalFishType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeAquatic(NewTropicalFish, data)
+	t := m.Behaviour().(*tropicalFishBehaviour)
+	if _, ok := data["Variant"]; ok {
+		t.shape, t.pattern = nbtconv.Int32(data, "Variant")%2, nbtconv.Int32(data, "MarkVariant")%6
+		t.base = item.Colours()[int(nbtconv.Uint8(data, "Color"))%len(item.Colours())]
+		t.patternColour = item.Colours()[int(nbtconv.Uint8(data, "Color2"))%len(item.Colours())]
+	}
+	return m
+}
+
+func (TropicalFishType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	t := m.Behaviour().(*tropicalFishBehaviour)
+	data := encodeAquatic(m)
+	data["Variant"], data["MarkVariant"] = t.shape, t.pattern
+	data["Color"], data["Color2"] = t.base.Uint8(), t.patternColour.Uint8()
+	return data
+}
+
+// PufferfishType is a world.EntityType implementation for pufferfish.
+type PufferfishType struct{}
+
+func (PufferfishType) EncodeEntity() string { return "minecraft:pufferfish" }
+func (PufferfishType) BucketName() string   { return "pufferfish" }
+
+// BBox returns the bounding box of the pufferfish, which grows as it puffs
+// up.
+func (PufferfishType) BBox(e world.Entity) cube.BBox {
+	size := 0.35
+	if m, ok := e.(*Mob); ok {
+		if p, ok := m.Behaviour().(*pufferfishBehaviour); ok {
+			size = []float64{0.35, 0.5, 0.7}[p.puff]
+		}
+	}
+	return cube.Box(-size/2, 0, -size/2, size/2, size, size/2)
+}
+
+func (PufferfishType) FromBucket(pos mgl64.Vec3) world.Entity {
+	return releasedFromBucket(NewPufferfish(pos))
+}
+
+func (PufferfishType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeAquatic(NewPufferfish, data)
+	m.Behaviour().(*pufferfishBehaviour).puff = int(nbtconv.Int32(data, "PuffState")) % 3
+	return m
+}
+
+func (PufferfishType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	data := encodeAquatic(m)
+	data["PuffState"] = int32(m.Behaviour().(*pufferfishBehaviour).puff)
+	return data
+}
@@ -0,0 +1,293 @@
+package entity
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/cube/trace"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewFishingHook creates a fishing hook entity at a position with an owner
+// entity. lure and luckOfTheSea are the levels of the Lure and Luck of the Sea
+// enchantments of the fishing rod that cast the hook.
+func NewFishingHook(pos mgl64.Vec3, owner world.Entity, lure, luckOfTheSea int) *Ent {
+	return Config{Behaviour: FishingHookBehaviourConfig{
+		Lure:         lure,
+		LuckOfTheSea: luckOfTheSea,
+	}.New(owner)}.New(FishingHookType{}, pos)
+}
+
+// FishingHookBehaviourConfig holds optional parameters for a
+// FishingHookBehaviour.
+type FishingHookBehaviourConfig struct {
+	// Lure is the level of the Lure enchantment of the fishing rod that cast
+	// the hook. Every level reduces the time until a fish bites.
+	Lure int
+	// LuckOfTheSea is the level of the Luck of the Sea enchantment of the
+	// fishing rod that cast the hook. Higher levels increase the chance of
+	// catching treasure.
+	LuckOfTheSea int
+}
+
+// New creates a FishingHookBehaviour using the parameters in conf and an owner
+// that cast the hook.
+func (conf FishingHookBehaviourConfig) New(owner world.Entity) *FishingHookBehaviour {
+	return &FishingHookBehaviour{conf: conf, owner: owner, mc: &MovementComputer{}}
+}
+
+// FishingHookBehaviour implements the behaviour of fishing hooks. A fishing
+// hook flies like a projectile, floats on water and hooks the first entity it
+// hits. Once in water, fish will bite after some time, which can be caught by
+// reeling in the hook.
+type FishingHookBehaviour struct {
+	conf  FishingHookBehaviourConfig
+	owner world.Entity
+	mc    *MovementComputer
+
+	mu     sync.Mutex
+	close  bool
+	hooked world.Entity
+	// waitTime is the time left until a fish starts approaching the hook,
+	// lureTime the time left until the approaching fish bites and biteTime the
+	// time left until the biting fish escapes.
+	waitTime, lureTime, biteTime time.Duration
+}
+
+// Owner returns the entity that cast the fishing hook.
+func (f *FishingHookBehaviour) Owner() world.Entity {
+	return f.owner
+}
+
+// Hooked returns the entity currently hooked by the fishing hook. False is
+// returned if no entity is hooked.
+func (f *FishingHookBehaviour) Hooked() (world.Entity, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.hooked, f.hooked != nil
+}
+
+// Catch returns the item stack and experience that would be caught if the
+// hook was reeled in now. False is returned if no fish is biting the hook.
+func (f *FishingHookBehaviour) Catch() (item.Stack, int, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.hooked != nil || f.biteTime <= 0 {
+		return item.Stack{}, 0, false
+	}
+	return fishingLoot(f.conf.LuckOfTheSea), 1 + rand.Intn(6), true
+}
+
+// Reel reels in the fishing hook, removing it from the world. A hooked entity
+// is pulled towards the owner of the hook. If the item stack passed is not
+// empty, it is launched towards the owner, along with the amount of
+// experience passed. The durability lost by the fishing rod is returned.
+func (f *FishingHookBehaviour) Reel(e *Ent, it item.Stack, xp int) int {
+	f.mu.Lock()
+	hooked := f.hooked
+	f.close, f.hooked = true, nil
+	f.mu.Unlock()
+
+	w, pos, ownerPos := e.World(), e.Position(), f.owner.Position()
+	if hooked != nil {
+		if v, ok := hooked.(interface {
+			Velocity() mgl64.Vec3
+			SetVelocity(v mgl64.Vec3)
+		}); ok {
+			v.SetVelocity(v.Velocity().Add(ownerPos.Sub(hooked.Position()).Mul(0.1)))
+		}
+		return 5
+	}
+	if !it.Empty() {
+		d := ownerPos.Sub(pos)
+		ent := NewItem(it, pos)
+		ent.vel = mgl64.Vec3{d[0] * 0.1, d[1]*0.1 + math.Sqrt(d.Len())*0.08, d[2] * 0.1}
+		w.AddEntity(ent)
+		for _, orb := range NewExperienceOrbs(ownerPos, xp) {
+			w.AddEntity(orb)
+		}
+		return 1
+	}
+	if f.mc.OnGround() {
+		return 2
+	}
+	return 0
+}
+
+// Tick moves the fishing hook, makes it float on water and runs the logic of
+// fish biting the hook.
+func (f *FishingHookBehaviour) Tick(e *Ent) *Movement {
+	f.mu.Lock()
+	if f.close {
+		f.mu.Unlock()
+		_ = e.Close()
+		return nil
+	}
+	f.mu.Unlock()
+
+	w := e.World()
+	if !f.ownerNearby(e, w) {
+		f.mu.Lock()
+		f.close = true
+		f.mu.Unlock()
+		return nil
+	}
+	if m, ok := f.tickHooked(e, w); ok {
+		return m
+	}
+
+	e.mu.Lock()
+	pos, vel, rot := e.pos, e.vel, e.rot
+	e.mu.Unlock()
+
+	box := e.Type().BBox(e).Translate(pos)
+	level, inWater := boatWaterLevel(w, box)
+	if inWater {
+		// The hook floats slightly below the surface of the water.
+		vel[1] = (vel[1] - 0.03 + (level-pos[1])/box.Height()*0.1) * 0.75
+		vel[0] *= 0.9
+		vel[2] *= 0.9
+	} else {
+		vel[1] -= 0.03
+		vel = vel.Mul(0.92)
+	}
+	m := f.mc.TickMovement(e, pos, vel, rot)
+	if !inWater {
+		f.tryHook(e, w, pos, m.pos)
+	}
+	e.mu.Lock()
+	e.pos, e.vel = m.pos, m.vel
+	e.mu.Unlock()
+
+	if inWater {
+		f.tickFishing(e, w, m.pos)
+	} else {
+		f.mu.Lock()
+		f.waitTime, f.lureTime, f.biteTime = 0, 0, 0
+		f.mu.Unlock()
+	}
+	return m
+}
+
+// ownerNearby checks if the owner of the hook is still in the same world,
+// within 32 blocks of the hook and holding a fishing rod.
+func (f *FishingHookBehaviour) ownerNearby(e *Ent, w *world.World) bool {
+	if f.owner == nil || f.owner.World() != w {
+		return false
+	}
+	if u, ok := f.owner.(interface {
+		HeldItems() (mainHand, offHand item.Stack)
+	}); ok {
+		if held, _ := u.HeldItems(); held.Empty() {
+			return false
+		} else if _, ok := held.Item().(item.FishingRod); !ok {
+			return false
+		}
+	}
+	return f.owner.Position().Sub(e.Position()).Len() <= 32
+}
+
+// tickHooked moves the hook along with the entity it hooked, if any. False is
+// returned if no entity is hooked.
+func (f *FishingHookBehaviour) tickHooked(e *Ent, w *world.World) (*Movement, bool) {
+	f.mu.Lock()
+	hooked := f.hooked
+	if hooked != nil && hooked.World() != w {
+		// The hooked entity was removed or changed worlds.
+		f.hooked, hooked = nil, nil
+	}
+	f.mu.Unlock()
+	if hooked == nil {
+		return nil, false
+	}
+	pos := hooked.Position().Add(mgl64.Vec3{0, hooked.Type().BBox(hooked).Height() * 0.8})
+
+	e.mu.Lock()
+	before := e.pos
+	e.pos, e.vel = pos, mgl64.Vec3{}
+	e.mu.Unlock()
+	return &Movement{v: w.Viewers(pos), e: e, pos: pos, dpos: pos.Sub(before), rot: e.Rotation()}, true
+}
+
+// tryHook checks if the hook hit a living entity while moving from start to
+// end and hooks it if so.
+func (f *FishingHookBehaviour) tryHook(e *Ent, w *world.World, start, end mgl64.Vec3) {
+	if start.ApproxEqualThreshold(end, epsilon) {
+		return
+	}
+	ignores := func(other world.Entity) bool {
+		g, ok := other.(interface{ GameMode() world.GameMode })
+		_, living := other.(Living)
+		return (ok && !g.GameMode().HasCollision()) || other == e || !living || other == f.owner
+	}
+	if res, ok := trace.Perform(start, end, w, e.Type().BBox(e).Grow(1.0), ignores); ok {
+		if r, ok := res.(trace.EntityResult); ok {
+			f.mu.Lock()
+			f.hooked = r.Entity()
+			f.mu.Unlock()
+			for _, v := range w.Viewers(end) {
+				v.ViewEntityState(e)
+			}
+		}
+	}
+}
+
+// tickFishing progresses the time until a fish bites the hook. Rain above the
+// hook speeds this up, while a hook that cannot see the sky is slowed down.
+func (f *FishingHookBehaviour) tickFishing(e *Ent, w *world.World, pos mgl64.Vec3) {
+	const tick = time.Second / 20
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case f.biteTime > 0:
+		if f.biteTime -= tick; f.biteTime <= 0 {
+			// The fish got away: Start waiting for a new fish.
+			f.waitTime = 0
+		}
+	case f.lureTime > 0:
+		if f.lureTime -= tick; f.lureTime > 0 {
+			if rand.Float64() < 0.15 {
+				for _, v := range w.Viewers(pos) {
+					v.ViewEntityAction(e, FishingHookBubbleAction{})
+				}
+			}
+			return
+		}
+		f.biteTime = time.Duration(20+rand.Intn(21)) * tick
+		e.mu.Lock()
+		e.vel[1] -= 0.2 + rand.Float64()*0.2
+		e.mu.Unlock()
+		for _, v := range w.Viewers(pos) {
+			v.ViewEntityAction(e, FishingHookBiteAction{})
+		}
+	case f.waitTime > 0:
+		speed, above := 1, cube.PosFromVec3(pos).Side(cube.FaceUp)
+		if rand.Float64() < 0.25 && w.RainingAt(above) {
+			speed++
+		}
+		if rand.Float64() < 0.5 && w.HighestLightBlocker(above.X(), above.Z()) > above.Y() {
+			speed--
+		}
+		if f.waitTime -= tick * time.Duration(speed); f.waitTime <= 0 {
+			f.lureTime = time.Duration(20+rand.Intn(61)) * tick
+		}
+	default:
+		wait := time.Duration(100+rand.Intn(501))*tick - enchantment.Lure{}.WaitTimeReduction(f.conf.Lure)
+		f.waitTime = max(wait, tick)
+	}
+}
+
+// FishingHookType is a world.EntityType implementation for fishing hooks.
+type FishingHookType struct{}
+
+func (FishingHookType) EncodeEntity() string { return "minecraft:fishing_hook" }
+func (FishingHookType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
+}
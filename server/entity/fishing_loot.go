@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/item/loot"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+func init() {
+	// The fishing loot tables are registered by default, but may be replaced
+	// using loot.Register or loot.LoadPack to customise the items caught.
+	loot.Register(loot.FishingFish, fishingTable(
+		fishingEntry(item.Cod{}, 60),
+		fishingEntry(item.Salmon{}, 25),
+		fishingEntry(item.TropicalFish{}, 2),
+		fishingEntry(item.Pufferfish{}, 13),
+	))
+	enchanted := loot.EnchantWithLevels{Levels: loot.Range{Min: 30, Max: 30}, Treasure: true}
+	loot.Register(loot.FishingTreasure, fishingTable(
+		fishingEntry(item.Bow{}, 1, fishingDamage, enchanted),
+		fishingEntry(item.FishingRod{}, 1, fishingDamage, enchanted),
+		fishingEntry(item.Book{}, 1, enchanted),
+		fishingEntry(item.NautilusShell{}, 1),
+	))
+	loot.Register(loot.FishingJunk, fishingTable(
+		fishingEntry(block.Waterlily{}, 17),
+		fishingEntry(item.Bowl{}, 10),
+		fishingEntry(item.Leather{}, 10),
+		fishingEntry(item.Boots{Tier: item.ArmourTierLeather{}}, 10, fishingDamage),
+		fishingEntry(item.RottenFlesh{}, 10),
+		fishingEntry(item.Potion{Type: potion.Water()}, 10),
+		fishingEntry(item.Bone{}, 10),
+		fishingEntry(item.Stick{}, 5),
+		fishingEntry(item.String{}, 5),
+		fishingEntry(item.FishingRod{}, 2, fishingDamage),
+		fishingEntry(item.InkSac{}, 1, loot.SetCount{Count: loot.Range{Min: 10, Max: 10}}),
+	))
+}
+
+// fishingDamage is the loot.Function that damages durable items caught while
+// fishing, leaving them with 10% to 100% of their durability.
+var fishingDamage = loot.SetDamage{Damage: loot.Range{Min: 0.1, Max: 1}}
+
+// fishingTable returns a loot.Table with a single pool that is rolled once,
+// selecting one of the entries passed.
+func fishingTable(entries ...loot.Entry) loot.Table {
+	return loot.Table{Pools: []loot.Pool{{Rolls: loot.Range{Min: 1, Max: 1}, Entries: entries}}}
+}
+
+// fishingEntry returns a loot.Entry producing the item passed with the weight
+// and functions passed.
+func fishingEntry(it world.Item, weight int, functions ...loot.Function) loot.Entry {
+	return loot.Entry{Item: it, Weight: weight, Functions: functions}
+}
+
+// fishingLoot returns a random item stack caught while fishing with a fishing
+// rod with the Luck of the Sea level passed. The item is generated by the
+// fish, treasure or junk loot table. An empty stack is returned if the loot
+// table selected does not produce any items.
+func fishingLoot(luck int) item.Stack {
+	r, l := rand.Float64(), enchantment.LuckOfTheSea{}
+	name := loot.FishingFish
+	switch junk := l.JunkChance(luck); {
+	case r < junk:
+		name = loot.FishingJunk
+	case r < junk+l.TreasureChance(luck):
+		name = loot.FishingTreasure
+	}
+	t, ok := loot.Lookup(name)
+	if !ok {
+		return item.Stack{}
+	}
+	if stacks := t.Generate(loot.Context{}); len(stacks) > 0 {
+		return stacks[0]
+	}
+	return item.Stack{}
+}
@@ -4,6 +4,9 @@ type (
 	// FoodHealingSource is a healing source used for when an entity regenerates health automatically when their food
 	// bar is at least 90% filled.
 	FoodHealingSource struct{}
+	// FeedHealingSource is a healing source used for when a tamed animal is healed by being fed by a player.
+	FeedHealingSource struct{}
 )
 
 func (FoodHealingSource) HealingSource() {}
+func (FeedHealingSource) HealingSource() {}
@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
@@ -70,6 +72,9 @@ func (i *ItemBehaviour) Tick(e *Ent) *Movement {
 
 // tick checks if the item can be picked up or merged with nearby item stacks.
 func (i *ItemBehaviour) tick(e *Ent) {
+	if i.collectByHopper(e) {
+		return
+	}
 	if i.pickupDelay == 0 {
 		i.checkNearby(e)
 	} else if i.pickupDelay < math.MaxInt16*(time.Second/20) {
@@ -105,6 +110,62 @@ func (i *ItemBehaviour) checkNearby(e *Ent) {
 	}
 }
 
+// collectByHopper attempts to have the item collected by a hopper that the item
+// entity is inside of or resting on. Hoppers collect items regardless of the
+// pickup delay of the item. True is returned if (part of) the item was
+// collected.
+func (i *ItemBehaviour) collectByHopper(e *Ent) bool {
+	w, pos := e.World(), e.Position()
+	blockPos := cube.PosFromVec3(pos)
+	for _, p := range []cube.Pos{blockPos, blockPos.Side(cube.FaceDown)} {
+		h, ok := w.Block(p).(block.Hopper)
+		if !ok {
+			continue
+		}
+		n := h.Collect(i.i)
+		if n == 0 {
+			continue
+		}
+		i.shrink(e, n)
+		return true
+	}
+	return i.collectByHopperMinecart(e)
+}
+
+// collectByHopperMinecart attempts to have the item collected by a hopper
+// minecart that the item entity is close to. Hopper minecarts that are
+// activated do not collect items. True is returned if (part of) the item was
+// collected.
+func (i *ItemBehaviour) collectByHopperMinecart(e *Ent) bool {
+	w, pos := e.World(), e.Position()
+	for _, other := range w.EntitiesWithin(cube.Box(-0.5, -1, -0.5, 0.5, 0.5, 0.5).Translate(pos), nil) {
+		if _, ok := other.Type().(HopperMinecartType); !ok {
+			continue
+		}
+		mb := other.(*Ent).Behaviour().(*MinecartBehaviour)
+		if mb.Activated() {
+			continue
+		}
+		n, _ := mb.Inventory().AddItem(i.i)
+		if n == 0 {
+			continue
+		}
+		i.shrink(e, n)
+		return true
+	}
+	return false
+}
+
+// shrink removes n items from the stack of the item entity, keeping the
+// entity itself in the world. The entity is closed if no items are left.
+func (i *ItemBehaviour) shrink(e *Ent, n int) {
+	if n >= i.i.Count() {
+		_ = e.Close()
+		return
+	}
+	i.i = i.i.Grow(-n)
+}
+
 // merge merges the item entity with another item entity.
 func (i *ItemBehaviour) merge(e *Ent, other *Ent) bool {
 	w, pos := e.World(), e.Position()
@@ -0,0 +1,213 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewMinecart creates a minecart entity at the position passed. Minecarts
+// follow the rails that they are placed on and may be ridden by players.
+func NewMinecart(pos mgl64.Vec3) *Ent {
+	return Config{Behaviour: minecartConf.New()}.New(MinecartType{}, pos)
+}
+
+// NewChestMinecart creates a minecart carrying a chest at the position passed.
+// Chest minecarts have an inventory of 27 slots.
+func NewChestMinecart(pos mgl64.Vec3) *Ent {
+	return Config{Behaviour: chestMinecartConf.New()}.New(ChestMinecartType{}, pos)
+}
+
+// NewHopperMinecart creates a minecart carrying a hopper at the position
+// passed. Hopper minecarts pull items out of containers above them and collect
+// items lying on the ground.
+func NewHopperMinecart(pos mgl64.Vec3) *Ent {
+	return Config{Behaviour: hopperMinecartConf.New()}.New(HopperMinecartType{}, pos)
+}
+
+// NewTNTMinecart creates a minecart carrying TNT at the position passed. TNT
+// minecarts are primed when riding over a powered activator rail.
+func NewTNTMinecart(pos mgl64.Vec3) *Ent {
+	return Config{Behaviour: tntMinecartConf.New()}.New(TNTMinecartType{}, pos)
+}
+
+var (
+	minecartConf = MinecartBehaviourConfig{
+		Drops: []item.Stack{item.NewStack(item.Minecart{}, 1)},
+	}
+	chestMinecartConf = MinecartBehaviourConfig{
+		Block:         block.Chest{},
+		ContainerSize: 27,
+		Drops:         []item.Stack{item.NewStack(item.Minecart{}, 1), item.NewStack(block.NewChest(), 1)},
+	}
+	hopperMinecartConf = MinecartBehaviourConfig{
+		Block:         block.Hopper{Facing: cube.FaceDown},
+		ContainerSize: 5,
+		Drops:         []item.Stack{item.NewStack(item.Minecart{}, 1), item.NewStack(block.NewHopper(), 1)},
+		Tick:          tickHopperMinecart,
+	}
+	tntMinecartConf = MinecartBehaviourConfig{
+		Block:    block.TNT{},
+		Drops:    []item.Stack{item.NewStack(item.Minecart{}, 1), item.NewStack(block.TNT{}, 1)},
+		Activate: primeTNTMinecart,
+		Destroy:  destroyTNTMinecart,
+	}
+)
+
+// tickHopperMinecart pulls an item out of the container above a hopper
+// minecart every 4 ticks, unless the minecart is activated.
+func tickHopperMinecart(e *Ent) {
+	mb := e.Behaviour().(*MinecartBehaviour)
+	if mb.Activated() || e.Age()%(time.Second/5) != 0 {
+		return
+	}
+	pos := cube.PosFromVec3(e.Position()).Side(cube.FaceUp)
+	if src, ok := e.World().Block(pos).(block.Container); ok {
+		block.TransferItem(src, minecartContainer{inv: mb.Inventory()}, cube.FaceDown, cube.FaceUp)
+	}
+}
+
+// primeTNTMinecart primes a TNT minecart, making it explode after 4 seconds.
+func primeTNTMinecart(e *Ent) {
+	e.Behaviour().(*MinecartBehaviour).Prime(e, time.Second*4)
+}
+
+// destroyTNTMinecart makes a TNT minecart explode if it was not destroyed by
+// an attack. TNT minecarts that explode do not drop any items.
+func destroyTNTMinecart(e *Ent, src world.DamageSource) bool {
+	if _, ok := src.(AttackDamageSource); ok {
+		return true
+	}
+	explodeTNT(e)
+	return false
+}
+
+// minecartContainer wraps around the inventory of a minecart so that items may
+// be moved into it using block.TransferItem.
+type minecartContainer struct {
+	inv *inventory.Inventory
+}
+
+func (minecartContainer) AddViewer(block.ContainerViewer, *world.World, cube.Pos)    {}
+func (minecartContainer) RemoveViewer(block.ContainerViewer, *world.World, cube.Pos) {}
+func (c minecartContainer) Inventory() *inventory.Inventory                          { return c.inv }
+
+// MinecartType is a world.EntityType implementation for Minecart.
+type MinecartType struct{}
+
+func (MinecartType) EncodeEntity() string   { return "minecraft:minecart" }
+func (MinecartType) NetworkOffset() float64 { return 0.35 }
+func (MinecartType) Minecart()              {}
+func (MinecartType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.49, 0, -0.49, 0.49, 0.7, 0.49)
+}
+
+func (MinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecart(NewMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (MinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecart(e.(*Ent))
+}
+
+// ChestMinecartType is a world.EntityType implementation for ChestMinecart.
+type ChestMinecartType struct{}
+
+func (ChestMinecartType) EncodeEntity() string   { return "minecraft:chest_minecart" }
+func (ChestMinecartType) NetworkOffset() float64 { return 0.35 }
+func (ChestMinecartType) Minecart()              {}
+func (ChestMinecartType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.49, 0, -0.49, 0.49, 0.7, 0.49)
+}
+
+func (ChestMinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecart(NewChestMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (ChestMinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecart(e.(*Ent))
+}
+
+// HopperMinecartType is a world.EntityType implementation for HopperMinecart.
+type HopperMinecartType struct{}
+
+func (HopperMinecartType) EncodeEntity() string   { return "minecraft:hopper_minecart" }
+func (HopperMinecartType) NetworkOffset() float64 { return 0.35 }
+func (HopperMinecartType) Minecart()              {}
+func (HopperMinecartType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.49, 0, -0.49, 0.49, 0.7, 0.49)
+}
+
+func (HopperMinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecart(NewHopperMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (HopperMinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecart(e.(*Ent))
+}
+
+// TNTMinecartType is a world.EntityType implementation for TNTMinecart.
+type TNTMinecartType struct{}
+
+func (TNTMinecartType) EncodeEntity() string   { return "minecraft:tnt_minecart" }
+func (TNTMinecartType) NetworkOffset() float64 { return 0.35 }
+func (TNTMinecartType) Minecart()              {}
+func (TNTMinecartType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.49, 0, -0.49, 0.49, 0.7, 0.49)
+}
+
+func (TNTMinecartType) DecodeNBT(m map[string]any) world.Entity {
+	e := decodeMinecart(NewTNTMinecart(nbtconv.Vec3(m, "Pos")), m)
+	if fuse, ok := m["Fuse"].(int32); ok && fuse >= 0 {
+		e.Behaviour().(*MinecartBehaviour).fuse = time.Duration(fuse) * time.Second / 20
+	}
+	return e
+}
+
+func (TNTMinecartType) EncodeNBT(e world.Entity) map[string]any {
+	m := encodeMinecart(e.(*Ent))
+	fuse := e.(*Ent).Behaviour().(*MinecartBehaviour).Fuse()
+	if fuse < 0 {
+		m["Fuse"] = int32(-1)
+	} else {
+		m["Fuse"] = int32(fuse.Milliseconds() / 50)
+	}
+	return m
+}
+
+// decodeMinecart decodes the properties shared by all minecarts from the NBT
+// map passed into the minecart e.
+func decodeMinecart(e *Ent, m map[string]any) *Ent {
+	e.vel = nbtconv.Vec3(m, "Motion")
+	e.rot = nbtconv.Rotation(m)
+	if uniqueID, ok := m["UniqueID"].(int64); ok {
+		e.uniqueID = uniqueID
+	}
+	if inv := e.Behaviour().(*MinecartBehaviour).Inventory(); inv != nil {
+		nbtconv.InvFromNBT(inv, nbtconv.Slice[any](m, "Items"))
+	}
+	return e
+}
+
+// encodeMinecart encodes the properties shared by all minecarts into an NBT
+// map.
+func encodeMinecart(e *Ent) map[string]any {
+	yaw, pitch := e.Rotation().Elem()
+	m := map[string]any{
+		"UniqueID": e.uniqueID,
+		"Pos":      nbtconv.Vec3ToFloat32Slice(e.Position()),
+		"Motion":   nbtconv.Vec3ToFloat32Slice(e.Velocity()),
+		"Yaw":      float32(yaw),
+		"Pitch":    float32(pitch),
+	}
+	if inv := e.Behaviour().(*MinecartBehaviour).Inventory(); inv != nil {
+		m["Items"] = nbtconv.InvToNBT(inv)
+	}
+	return m
+}
@@ -0,0 +1,426 @@
+package entity
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// MinecartBehaviourConfig holds optional parameters for a MinecartBehaviour.
+type MinecartBehaviourConfig struct {
+	// Block is the block displayed inside the minecart, such as a chest or a
+	// hopper. Minecarts without a Block may be ridden by a Rider.
+	Block world.Block
+	// ContainerSize is the amount of slots of the inventory of the minecart.
+	// If ContainerSize is 0, the minecart does not have an inventory.
+	ContainerSize int
+	// Drops are the items dropped when the minecart is destroyed. The contents
+	// of the inventory of the minecart are always dropped.
+	Drops []item.Stack
+	// Tick is called for every tick that the minecart is alive. Tick is called
+	// after the minecart moves on a tick.
+	Tick func(e *Ent)
+	// Activate is called when the minecart rides over an activator rail that
+	// is powered.
+	Activate func(e *Ent)
+	// Destroy is called when the minecart is destroyed by the damage source
+	// passed. If Destroy returns false, the minecart does not drop any items.
+	Destroy func(e *Ent, src world.DamageSource) bool
+}
+
+// New creates a MinecartBehaviour using the parameters in conf.
+func (conf MinecartBehaviourConfig) New() *MinecartBehaviour {
+	seats := []mgl64.Vec3{{0, 1.02, 0}}
+	if conf.Block != nil {
+		// Minecarts displaying a block cannot be ridden.
+		seats = nil
+	}
+	return &MinecartBehaviour{
+		Seats:     NewSeats(seats...),
+		container: newContainer(conf.ContainerSize),
+		conf:      conf,
+		fuse:      -1,
+		mc:        &MovementComputer{Gravity: 0.04, Drag: 0.05, DragBeforeGravity: true},
+		railMC:    &MovementComputer{},
+	}
+}
+
+const (
+	// minecartMaxSpeed is the maximum speed in blocks/tick that a minecart is
+	// able to move at on rails.
+	minecartMaxSpeed = 0.4
+	// minecartSlopeAcceleration is the acceleration in blocks/tick² that a
+	// minecart gets when riding down a sloped rail.
+	minecartSlopeAcceleration = 0.0078125
+	// minecartPoweredRailAcceleration is the acceleration in blocks/tick² that
+	// a minecart gets when riding over a powered rail.
+	minecartPoweredRailAcceleration = 0.06
+	// minecartMaxDamage is the amount of damage that a minecart is able to take
+	// before being destroyed.
+	minecartMaxDamage = 40
+)
+
+// MinecartBehaviour implements the behaviour of minecarts. Minecarts follow
+// the rails they are placed on, are accelerated by powered rails and may be
+// ridden or carry an inventory.
+type MinecartBehaviour struct {
+	*Seats
+	*container
+
+	conf       MinecartBehaviourConfig
+	mc, railMC *MovementComputer
+
+	mu        sync.Mutex
+	damage    float64
+	activated bool
+	fuse      time.Duration
+	close     bool
+}
+
+// DisplayBlock returns the block displayed inside the minecart, if any.
+func (mb *MinecartBehaviour) DisplayBlock() (world.Block, bool) {
+	return mb.conf.Block, mb.conf.Block != nil
+}
+
+// Activated checks if the minecart was last on an activator rail that was
+// powered. Hopper minecarts stop collecting items while activated.
+func (mb *MinecartBehaviour) Activated() bool {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	return mb.activated
+}
+
+// Fuse returns the time left until the minecart explodes after it was primed
+// using Prime. If the minecart was not primed, -1 is returned.
+func (mb *MinecartBehaviour) Fuse() time.Duration {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	return mb.fuse
+}
+
+// Prime primes the minecart. Once the fuse passed runs out, the minecart is
+// destroyed by an ExplosionDamageSource, which MinecartBehaviourConfig.Destroy
+// may use to create an explosion. Prime does nothing if the minecart was
+// already primed.
+func (mb *MinecartBehaviour) Prime(e *Ent, fuse time.Duration) {
+	mb.mu.Lock()
+	if mb.fuse >= 0 {
+		mb.mu.Unlock()
+		return
+	}
+	mb.fuse = fuse
+	mb.mu.Unlock()
+
+	for _, v := range e.World().Viewers(e.Position()) {
+		v.ViewEntityState(e)
+	}
+}
+
+// Interact makes the user passed ride the minecart, or opens its inventory if
+// it has one.
+func (mb *MinecartBehaviour) Interact(e *Ent, user item.User) bool {
+	if mb.Inventory() != nil {
+		if opener, ok := user.(interface{ OpenEntityContainer(e world.Entity) }); ok {
+			opener.OpenEntityContainer(e)
+			return true
+		}
+		return false
+	}
+	r, ok := user.(Rider)
+	if !ok || mb.Full() {
+		return false
+	}
+	if s, ok := user.(interface{ Sneaking() bool }); ok && s.Sneaking() {
+		return false
+	}
+	if v, riding := r.RidingEntity(); riding && v == e {
+		return false
+	}
+	r.RideEntity(e)
+	return true
+}
+
+// Damage damages the minecart. Once the minecart has taken enough damage in a
+// short time, it is destroyed. Minecarts attacked by players in creative mode
+// are destroyed immediately without dropping any items.
+func (mb *MinecartBehaviour) Damage(e *Ent, damage float64, src world.DamageSource) bool {
+	if s, ok := src.(AttackDamageSource); ok {
+		if g, ok := s.Attacker.(interface{ GameMode() world.GameMode }); ok && g.GameMode().CreativeInventory() {
+			mb.destroy(e, src, false)
+			return true
+		}
+	}
+	for _, v := range e.World().Viewers(e.Position()) {
+		v.ViewEntityAction(e, HurtAction{})
+	}
+	mb.mu.Lock()
+	mb.damage += damage * 10
+	destroyed := mb.damage > minecartMaxDamage
+	mb.mu.Unlock()
+
+	if destroyed {
+		mb.destroy(e, src, true)
+	}
+	return true
+}
+
+// Explode pushes the minecart away from the explosion and damages it.
+func (mb *MinecartBehaviour) Explode(e *Ent, src mgl64.Vec3, impact float64, _ block.ExplosionConfig) {
+	if diff := e.Position().Sub(src); diff.Len() != 0 {
+		e.SetVelocity(e.Velocity().Add(diff.Normalize().Mul(impact)))
+	}
+	mb.Damage(e, impact*10, ExplosionDamageSource{})
+}
+
+// destroy removes the minecart, dismounting its passengers and dropping its items
+// if drops is true.
+func (mb *MinecartBehaviour) destroy(e *Ent, src world.DamageSource, drops bool) {
+	mb.mu.Lock()
+	if mb.close {
+		mb.mu.Unlock()
+		return
+	}
+	mb.close = true
+	mb.mu.Unlock()
+
+	mb.DismountAll()
+	if mb.conf.Destroy != nil && !mb.conf.Destroy(e, src) {
+		drops = false
+	}
+	if !drops {
+		return
+	}
+	w, pos := e.World(), e.Position()
+	for _, it := range mb.conf.Drops {
+		w.AddEntity(NewItem(it, pos))
+	}
+	if inv := mb.Inventory(); inv != nil {
+		for _, it := range inv.Clear() {
+			w.AddEntity(NewItem(it, pos))
+		}
+	}
+}
+
+// Tick moves the minecart along the rail that it is on, or makes it fall if
+// it is not on a rail.
+func (mb *MinecartBehaviour) Tick(e *Ent) *Movement {
+	mb.mu.Lock()
+	if mb.close {
+		mb.mu.Unlock()
+		_ = e.Close()
+		return nil
+	}
+	mb.damage = math.Max(mb.damage-1, 0)
+	mb.mu.Unlock()
+
+	w := e.World()
+	e.mu.Lock()
+	pos, vel, rot := e.pos, e.vel, e.rot
+	e.mu.Unlock()
+
+	var m *Movement
+	if railPos, rail, ok := minecartRail(w, pos); ok {
+		m = mb.tickRail(e, w, pos, vel, rot, railPos, rail)
+	} else {
+		m = mb.mc.TickMovement(e, pos, vel, rot)
+	}
+	e.mu.Lock()
+	e.pos, e.vel, e.rot = m.pos, m.vel, m.rot
+	e.mu.Unlock()
+
+	bpos := cube.PosFromVec3(m.pos)
+	if insider, ok := w.Block(bpos).(block.EntityInsider); ok {
+		insider.EntityInside(bpos, w, e)
+	}
+
+	mb.tickFuse(e)
+	if mb.conf.Tick != nil {
+		mb.conf.Tick(e)
+	}
+	return m
+}
+
+// tickFuse ticks the fuse of a primed minecart, destroying it with an
+// ExplosionDamageSource once the fuse runs out.
+func (mb *MinecartBehaviour) tickFuse(e *Ent) {
+	mb.mu.Lock()
+	if mb.fuse < 0 {
+		mb.mu.Unlock()
+		return
+	}
+	mb.fuse -= time.Second / 20
+	explode := mb.fuse < 0 && !mb.close
+	mb.mu.Unlock()
+
+	if explode {
+		mb.destroy(e, ExplosionDamageSource{}, false)
+	}
+}
+
+// tickRail moves the minecart along the rail found at railPos.
+func (mb *MinecartBehaviour) tickRail(e *Ent, w *world.World, pos, vel mgl64.Vec3, rot cube.Rotation, railPos cube.Pos, rail block.RailBlock) *Movement {
+	shape := rail.RailShape()
+	a, b := shape.Directions()
+	if up, ok := shape.Ascending(); ok {
+		vel = vel.Sub(directionVec3(up).Mul(minecartSlopeAcceleration))
+	}
+	// The track runs from the centre of the edge of side a to the centre of
+	// the edge of side b. The minecart moves along it in the direction closest
+	// to its current velocity.
+	start, end := railPos.Vec3Middle().Add(directionVec3(a).Mul(0.5)), railPos.Vec3Middle().Add(directionVec3(b).Mul(0.5))
+	track := end.Sub(start).Normalize()
+	if vel[0]*track[0]+vel[2]*track[2] < 0 {
+		track = track.Mul(-1)
+	}
+	speed := math.Hypot(vel[0], vel[2])
+
+	switch r := rail.(type) {
+	case block.PoweredRail:
+		if !r.Powered {
+			if speed < 0.03 {
+				speed = 0
+			} else {
+				speed *= 0.5
+			}
+		} else if speed > 0.01 {
+			speed += minecartPoweredRailAcceleration
+		} else if solidSide(w, railPos, a) {
+			track, speed = directionVec3(b), 0.02
+		} else if solidSide(w, railPos, b) {
+			track, speed = directionVec3(a), 0.02
+		}
+	case block.ActivatorRail:
+		mb.activate(e, r.Powered)
+	}
+	speed = math.Min(speed, minecartMaxSpeed)
+	vel = mgl64.Vec3{track[0] * speed, 0, track[2] * speed}
+
+	// Snap the minecart onto the track before moving it.
+	seg := end.Sub(start)
+	t := ((pos[0]-start[0])*seg[0] + (pos[2]-start[2])*seg[2]) / (seg[0]*seg[0] + seg[2]*seg[2])
+	t = mgl64.Clamp(t, 0, 1)
+	pos = mgl64.Vec3{start[0] + seg[0]*t, railHeight(railPos, shape, pos), start[2] + seg[2]*t}
+
+	before := vel
+	var m *Movement
+	if _, ascending := shape.Ascending(); ascending {
+		// Collision with the blocks next to a sloped rail is not checked, as the
+		// minecart would otherwise collide with the block that supports the
+		// higher end of the slope.
+		m = &Movement{v: w.Viewers(pos), e: e, pos: pos.Add(vel), vel: vel, dpos: vel, rot: rot, onGround: true}
+	} else {
+		m = mb.railMC.TickMovement(e, pos, vel, rot)
+	}
+	if next, r, ok := railNear(w, m.pos, railPos.Y()); ok {
+		m.pos[1] = railHeight(next, r.RailShape(), m.pos)
+	}
+	m.dpos = m.pos.Sub(e.Position())
+
+	friction := 0.96
+	if len(mb.Passengers()) > 0 {
+		friction = 0.997
+	}
+	m.vel = m.vel.Mul(friction)
+	m.dvel = m.vel.Sub(before)
+	if speed > 0.01 {
+		m.rot = cube.Rotation{mgl64.RadToDeg(math.Atan2(-track[0], track[2])), 0}
+	}
+	m.onGround = true
+	return m
+}
+
+// activate updates the activated state of the minecart. If the minecart was
+// not activated before, its passengers are dismounted and MinecartBehaviourConfig.
+// Activate is called.
+func (mb *MinecartBehaviour) activate(e *Ent, powered bool) {
+	mb.mu.Lock()
+	changed := powered && !mb.activated
+	mb.activated = powered
+	mb.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	mb.DismountAll()
+	if mb.conf.Activate != nil {
+		mb.conf.Activate(e)
+	}
+}
+
+// minecartRail returns the rail that a minecart at the position passed is on,
+// if any. A minecart may be in the block of the rail or just above it, at the
+// top of a sloped rail.
+func minecartRail(w *world.World, pos mgl64.Vec3) (cube.Pos, block.RailBlock, bool) {
+	p := cube.PosFromVec3(pos)
+	if r, ok := w.Block(p).(block.RailBlock); ok {
+		return p, r, true
+	}
+	if pos[1]-float64(p[1]) > 0.1 {
+		return cube.Pos{}, nil, false
+	}
+	p = p.Side(cube.FaceDown)
+	r, ok := w.Block(p).(block.RailBlock)
+	return p, r, ok
+}
+
+// railNear finds a rail in the column of the position passed, at most one
+// block above or below the y passed.
+func railNear(w *world.World, pos mgl64.Vec3, y int) (cube.Pos, block.RailBlock, bool) {
+	x, z := int(math.Floor(pos[0])), int(math.Floor(pos[2]))
+	for _, p := range [...]cube.Pos{{x, y, z}, {x, y + 1, z}, {x, y - 1, z}} {
+		if r, ok := w.Block(p).(block.RailBlock); ok {
+			return p, r, true
+		}
+	}
+	return cube.Pos{}, nil, false
+}
+
+// railHeight returns the height of the surface of the rail at the position
+// passed. For sloped rails, this depends on how far the position is along
+// the slope.
+func railHeight(railPos cube.Pos, shape block.RailShape, pos mgl64.Vec3) float64 {
+	up, ok := shape.Ascending()
+	if !ok {
+		return float64(railPos[1])
+	}
+	x, z := pos[0]-float64(railPos[0]), pos[2]-float64(railPos[2])
+	var f float64
+	switch up {
+	case cube.North:
+		f = 1 - z
+	case cube.South:
+		f = z
+	case cube.West:
+		f = 1 - x
+	case cube.East:
+		f = x
+	}
+	return float64(railPos[1]) + mgl64.Clamp(f, 0, 1)
+}
+
+// solidSide checks if the block next to the position passed in the direction
+// passed is solid.
+func solidSide(w *world.World, pos cube.Pos, d cube.Direction) bool {
+	side := pos.Side(d.Face())
+	return w.Block(side).Model().FaceSolid(side, d.Face().Opposite(), w)
+}
+
+// directionVec3 returns a horizontal unit vector pointing in the direction
+// passed.
+func directionVec3(d cube.Direction) mgl64.Vec3 {
+	switch d {
+	case cube.North:
+		return mgl64.Vec3{0, 0, -1}
+	case cube.South:
+		return mgl64.Vec3{0, 0, 1}
+	case cube.West:
+		return mgl64.Vec3{-1, 0, 0}
+	}
+	return mgl64.Vec3{1, 0, 0}
+}
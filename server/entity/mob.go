@@ -0,0 +1,1081 @@
+package entity
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/cube/trace"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/item/loot"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// MobConfig holds the properties of a Mob. Implementations of specific mobs,
+// such as animals and monsters, typically use a MobConfig to create a Mob.
+type MobConfig struct {
+	// Category is the world.MobCategory of the Mob. It decides the mob cap
+	// that the Mob counts towards and whether the Mob is despawned when it is
+	// far away from players.
+	Category world.MobCategory
+	// MaxHealth is the maximum health of the Mob. The Mob is created with this
+	// amount of health. If 0, a MaxHealth of 20 is used.
+	MaxHealth float64
+	// Speed is the movement speed of the Mob in blocks/tick. If 0, a speed of
+	// 0.25 is used.
+	Speed float64
+	// EyeHeight is the height of the eyes of the Mob, measured from the bottom
+	// of its bounding box. If 0, 85% of the height of the bounding box is used.
+	EyeHeight float64
+	// FireImmune specifies if the Mob is immune to fire damage.
+	FireImmune bool
+	// Aquatic specifies if the Mob lives in water. Aquatic Mobs are not pulled
+	// down by gravity while in water, never drown and instead run out of air
+	// while out of water.
+	Aquatic bool
+	// Undead specifies if the Mob is undead, such as a zombie. Undead Mobs
+	// take additional damage from weapons enchanted with Smite.
+	Undead bool
+	// Arthropod specifies if the Mob is an arthropod, such as a spider.
+	// Arthropods take additional damage from weapons enchanted with Bane of
+	// Arthropods.
+	Arthropod bool
+	// AttackDamage is the damage dealt by the Mob when it attacks another
+	// entity using Mob.AttackEntity. If 0, an AttackDamage of 2 is used.
+	AttackDamage float64
+	// Experience returns the amount of experience that the Mob drops when it
+	// is killed by another entity. If nil, no experience is dropped.
+	Experience func(m *Mob) int
+	// Drops returns the items that the Mob drops when it dies. The damage
+	// source that killed the Mob is passed. If nil, no items are dropped
+	// other than the equipment of the Mob.
+	Drops func(m *Mob, src world.DamageSource) []item.Stack
+	// Behaviour implements the behaviour specific to the type of the Mob, such
+	// as its AI. If nil, the Mob does nothing but move as a result of its
+	// velocity.
+	Behaviour MobBehaviour
+}
+
+// MobBehaviour implements the behaviour of a specific type of Mob. A
+// MobBehaviour may additionally implement an Interact(m *Mob, user item.User)
+// bool method to handle entities interacting with the Mob.
+type MobBehaviour interface {
+	// Tick is called every tick that the Mob is alive, before its movement is
+	// computed.
+	Tick(m *Mob)
+}
+
+// New creates a Mob with an entity type and a position using the properties
+// in conf.
+func (conf MobConfig) New(t world.EntityType, pos mgl64.Vec3) *Mob {
+	if conf.MaxHealth == 0 {
+		conf.MaxHealth = 20
+	}
+	if conf.Speed == 0 {
+		conf.Speed = 0.25
+	}
+	if conf.AttackDamage == 0 {
+		conf.AttackDamage = 2
+	}
+	m := &Mob{
+		t:         t,
+		conf:      conf,
+		uniqueID:  rand.Int63(),
+		pos:       pos,
+		speed:     conf.Speed,
+		health:    NewHealthManager(conf.MaxHealth, conf.MaxHealth),
+		effects:   NewEffectManager(),
+		airSupply: mobMaxAirSupply,
+		mc:        &MovementComputer{Gravity: 0.08, Drag: 0.02},
+	}
+	m.armour = inventory.NewArmour(func(int, item.Stack, item.Stack) {
+		for _, v := range m.viewers() {
+			v.ViewEntityArmour(m)
+		}
+	})
+	return m
+}
+
+// mobMaxAirSupply is the amount of ticks that a Mob can stay under water
+// before it starts drowning.
+const mobMaxAirSupply = 300
+
+// Mob is a world.Entity implementation of a living entity that is not a
+// player, such as an animal or a monster. Mob implements Living and shares
+// the functionality common to all mobs: health, effects, armour, held items,
+// fire, drowning and fall damage, and dropping items and experience on death.
+type Mob struct {
+	uniqueID int64
+	conf     MobConfig
+	t        world.EntityType
+	armour   *inventory.Armour
+	health   *HealthManager
+	effects  *EffectManager
+	mc       *MovementComputer
+
+	mu  sync.Mutex
+	pos mgl64.Vec3
+	vel mgl64.Vec3
+	rot cube.Rotation
+
+	name               string
+	speed              float64
+	mainHand, offHand  item.Stack
+	fireDuration       time.Duration
+	age                time.Duration
+	immunity           time.Duration
+	fallDistance       float64
+	airSupply          int64
+	recentlyAttacked   int
+	lastAttacker       world.Entity
+	viewedRot          cube.Rotation
+	deathTicks         int
+	collidedHorizontal bool
+}
+
+// Type returns the world.EntityType passed to MobConfig.New.
+func (m *Mob) Type() world.EntityType {
+	return m.t
+}
+
+// Undead checks if the Mob is undead, as specified in its MobConfig.
+func (m *Mob) Undead() bool {
+	return m.conf.Undead
+}
+
+// Arthropod checks if the Mob is an arthropod, as specified in its MobConfig.
+func (m *Mob) Arthropod() bool {
+	return m.conf.Arthropod
+}
+
+// Position returns the current position of the Mob.
+func (m *Mob) Position() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.pos
+}
+
+// Velocity returns the current velocity of the Mob. The values in the Vec3
+// returned represent the speed on that axis in blocks/tick.
+func (m *Mob) Velocity() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.vel
+}
+
+// SetVelocity sets the velocity of the Mob. The values in the Vec3 passed
+// represent the speed on that axis in blocks/tick.
+func (m *Mob) SetVelocity(v mgl64.Vec3) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vel = v
+}
+
+// Rotation returns the rotation of the Mob.
+func (m *Mob) Rotation() cube.Rotation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rot
+}
+
+// SetRotation changes the rotation of the Mob. The new rotation is sent to
+// viewers the next time the Mob moves.
+func (m *Mob) SetRotation(r cube.Rotation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rot = r
+}
+
+// LookAt rotates the Mob so that its eyes look at the position passed.
+func (m *Mob) LookAt(pos mgl64.Vec3) {
+	diff := pos.Sub(EyePosition(m))
+	yaw := mgl64.RadToDeg(math.Atan2(-diff[0], diff[2]))
+	pitch := -mgl64.RadToDeg(math.Atan2(diff[1], math.Hypot(diff[0], diff[2])))
+	m.SetRotation(cube.Rotation{yaw, pitch})
+}
+
+// Behaviour returns the MobBehaviour of the Mob. Nil is returned if the Mob
+// has no MobBehaviour.
+func (m *Mob) Behaviour() MobBehaviour {
+	return m.conf.Behaviour
+}
+
+// MobCategory returns the world.MobCategory of the Mob.
+func (m *Mob) MobCategory() world.MobCategory {
+	return m.conf.Category
+}
+
+// Persistent checks if the Mob is never despawned when it is far away from
+// players. Creatures are always persistent, as are Mobs with a MobBehaviour
+// that implements a Persistent() bool method that returns true.
+func (m *Mob) Persistent() bool {
+	if p, ok := m.conf.Behaviour.(interface{ Persistent() bool }); ok && p.Persistent() {
+		return true
+	}
+	return m.conf.Category == world.MobCategoryCreature
+}
+
+// Owner returns the owner of the Mob if its MobBehaviour has one, such as a
+// tamed animal. False is returned if the Mob has no owner, or if the owner is
+// not in the same world as the Mob.
+func (m *Mob) Owner() (world.Entity, bool) {
+	if o, ok := m.conf.Behaviour.(interface{ Owner() (world.Entity, bool) }); ok {
+		return o.Owner()
+	}
+	return nil, false
+}
+
+// Interact propagates the interaction behaviour of the MobBehaviour of the
+// Mob. False is returned if the Mob cannot be interacted with.
+func (m *Mob) Interact(user item.User) bool {
+	if i, ok := m.conf.Behaviour.(interface {
+		Interact(m *Mob, user item.User) bool
+	}); ok && !m.Dead() {
+		return i.Interact(m, user)
+	}
+	return false
+}
+
+// World returns the world of the Mob.
+func (m *Mob) World() *world.World {
+	w, _ := world.OfEntity(m)
+	return w
+}
+
+// Age returns the total time lived of the Mob. It increases by time.Second/20
+// for every time Tick is called.
+func (m *Mob) Age() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.age
+}
+
+// EyeHeight returns the height of the eyes of the Mob.
+func (m *Mob) EyeHeight() float64 {
+	if m.conf.EyeHeight != 0 {
+		return m.conf.EyeHeight
+	}
+	return m.t.BBox(m).Height() * 0.85
+}
+
+// OnGround checks if the Mob is currently standing on the ground.
+func (m *Mob) OnGround() bool {
+	return m.mc.OnGround()
+}
+
+// Teleport teleports the Mob to the position passed.
+func (m *Mob) Teleport(pos mgl64.Vec3) {
+	m.mu.Lock()
+	m.pos = pos
+	m.mu.Unlock()
+
+	for _, v := range m.viewers() {
+		v.ViewEntityTeleport(m, pos)
+	}
+}
+
+// Health returns the current health of the Mob.
+func (m *Mob) Health() float64 {
+	return m.health.Health()
+}
+
+// MaxHealth returns the maximum health of the Mob.
+func (m *Mob) MaxHealth() float64 {
+	return m.health.MaxHealth()
+}
+
+// SetMaxHealth changes the maximum health of the Mob. If the current health
+// of the Mob is higher than the new maximum health, the health is set to the
+// new maximum.
+func (m *Mob) SetMaxHealth(v float64) {
+	m.health.SetMaxHealth(v)
+	m.updateState()
+}
+
+// Dead checks if the Mob is dead.
+func (m *Mob) Dead() bool {
+	return m.health.Health() <= mgl64.Epsilon
+}
+
+// AttackImmune checks if the Mob is currently immune to entity attacks,
+// meaning it was recently attacked.
+func (m *Mob) AttackImmune() bool {
+	return m.AttackImmunity() > 0
+}
+
+// AttackImmunity returns the duration that the Mob is immune to entity
+// attacks.
+func (m *Mob) AttackImmunity() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.immunity
+}
+
+// SetAttackImmunity sets the duration that the Mob is immune to entity
+// attacks.
+func (m *Mob) SetAttackImmunity(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.immunity = d
+}
+
+// Hurt hurts the Mob for a given amount of damage. The damage is reduced by
+// the armour worn by the Mob and its effects. If the final damage exceeds the
+// health of the Mob, it is killed. Hurt returns the final damage dealt to the
+// Mob and if the Mob was vulnerable to the damage.
+func (m *Mob) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
+	if m.Dead() || dmg < 0 {
+		return 0, false
+	}
+	if _, ok := m.Effect(effect.FireResistance{}); (ok || m.conf.FireImmune) && src.Fire() {
+		return 0, false
+	}
+	totalDamage := m.FinalDamageFrom(dmg, src)
+	m.health.AddHealth(-totalDamage)
+
+	var attacker world.Entity
+	if s, ok := src.(AttackDamageSource); ok {
+		attacker = s.Attacker
+	} else if s, ok := src.(ProjectileDamageSource); ok {
+		attacker = s.Owner
+	}
+	if attacker != nil {
+		m.mu.Lock()
+		m.recentlyAttacked, m.lastAttacker = 100, attacker
+		m.mu.Unlock()
+	}
+	if src.ReducedByArmour() {
+		m.armour.Damage(dmg, m.damageItem)
+		if l, ok := attacker.(Living); ok {
+			if thornsDmg := m.armour.ThornsDamage(m.damageItem); thornsDmg > 0 {
+				l.Hurt(thornsDmg, enchantment.ThornsDamageSource{Owner: m})
+			}
+		}
+	}
+
+	w, pos := m.World(), m.Position()
+	for _, v := range m.viewers() {
+		v.ViewEntityAction(m, HurtAction{})
+	}
+	if src.Fire() {
+		w.PlaySound(pos, sound.Burning{})
+	} else if _, ok := src.(DrowningDamageSource); ok {
+		w.PlaySound(pos, sound.Drowning{})
+	}
+	m.updateState()
+
+	m.SetAttackImmunity(time.Second / 2)
+	if m.Dead() {
+		m.kill(src)
+	}
+	return totalDamage, true
+}
+
+// LastAttacker returns the entity that last attacked the Mob, either directly
+// or using a projectile. False is returned if the Mob was not attacked in the
+// last 5 seconds.
+func (m *Mob) LastAttacker() (world.Entity, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastAttacker, m.lastAttacker != nil
+}
+
+// AttackEntity makes the Mob attack the entity passed, dealing the
+// AttackDamage of the MobConfig. Damage dealt to players is scaled by the
+// difficulty of the world. If the entity is not Living or is immune to
+// attacks, false is returned.
+func (m *Mob) AttackEntity(e world.Entity) bool {
+	l, ok := e.(Living)
+	if !ok || m.Dead() || l.AttackImmune() {
+		return false
+	}
+	dmg := m.conf.AttackDamage
+	if s, ok := m.Effect(effect.Strength{}); ok {
+		dmg += 3 * float64(s.Level())
+	}
+	if w, ok := m.Effect(effect.Weakness{}); ok {
+		dmg -= 4 * float64(w.Level())
+	}
+	if _, ok := e.(interface{ GameMode() world.GameMode }); ok {
+		dmg = scaleMobDamage(m.World().Difficulty(), dmg)
+	}
+	if _, vulnerable := l.Hurt(dmg, AttackDamageSource{Attacker: m}); !vulnerable {
+		return false
+	}
+	l.KnockBack(m.Position(), 0.4, 0.4)
+	for _, v := range m.viewers() {
+		v.ViewEntityAction(m, SwingArmAction{})
+	}
+	return true
+}
+
+// CanSee checks if the Mob is able to see the entity passed: no blocks must be
+// in the way between the eyes of the Mob and the eyes of the entity.
+func (m *Mob) CanSee(e world.Entity) bool {
+	w := m.World()
+	if w == nil || e.World() != w {
+		return false
+	}
+	start, end := EyePosition(m), EyePosition(e)
+	visible := true
+	trace.TraverseBlocks(start, end, func(pos cube.Pos) bool {
+		if _, ok := trace.BlockIntercept(pos, w, w.Block(pos), start, end); ok {
+			visible = false
+		}
+		return visible
+	})
+	return visible
+}
+
+// FinalDamageFrom resolves the final damage received by the Mob if it is
+// attacked by the source passed with the damage passed. FinalDamageFrom takes
+// into account the armour worn and the Resistance effect.
+func (m *Mob) FinalDamageFrom(dmg float64, src world.DamageSource) float64 {
+	dmg = math.Max(dmg, 0)
+
+	dmg -= m.armour.DamageReduction(dmg, src)
+	if res, ok := m.Effect(effect.Resistance{}); ok {
+		dmg *= effect.Resistance{}.Multiplier(src, res.Level())
+	}
+	return dmg
+}
+
+// Heal heals the Mob for a given amount of health. If the health passed is
+// negative, Heal does nothing.
+func (m *Mob) Heal(health float64, _ world.HealingSource) {
+	if m.Dead() || health < 0 {
+		return
+	}
+	m.health.AddHealth(health)
+	m.updateState()
+}
+
+// KnockBack knocks the Mob back with a given force and height. The source
+// passed is used to calculate the direction in which the Mob is knocked back.
+func (m *Mob) KnockBack(src mgl64.Vec3, force, height float64) {
+	if m.Dead() {
+		return
+	}
+	velocity := m.Position().Sub(src)
+	velocity[1] = 0
+
+	if velocity.Len() != 0 {
+		velocity = velocity.Normalize().Mul(force)
+	}
+	velocity[1] = height
+
+	m.SetVelocity(velocity.Mul(1 - m.armour.KnockBackResistance()))
+}
+
+// scaleMobDamage scales the damage that a hostile mob deals to a player based
+// on the difficulty passed. Damage is not scaled for custom difficulties.
+func scaleMobDamage(diff world.Difficulty, dmg float64) float64 {
+	switch diff {
+	case world.DifficultyPeaceful:
+		return 0
+	case world.DifficultyEasy:
+		return min(dmg/2+1, dmg)
+	case world.DifficultyHard:
+		return dmg * 1.5
+	}
+	return dmg
+}
+
+// Explode hurts the Mob and knocks it back from the explosion.
+func (m *Mob) Explode(src mgl64.Vec3, impact float64, conf block.ExplosionConfig) {
+	m.Hurt(math.Floor((impact*impact+impact)*3.5*conf.Size+1), ExplosionDamageSource{})
+	if diff := m.Position().Sub(src); diff.Len() != 0 {
+		m.KnockBack(src, impact, diff[1]/diff.Len()*impact)
+	}
+}
+
+// AddEffect adds an effect.Effect to the Mob. If the effect is instant, it is
+// applied immediately. If not, it is applied every time the Mob is ticked.
+func (m *Mob) AddEffect(e effect.Effect) {
+	m.effects.Add(e, m)
+	m.updateState()
+}
+
+// RemoveEffect removes any effect of the type passed that is active on the
+// Mob.
+func (m *Mob) RemoveEffect(e effect.Type) {
+	m.effects.Remove(e, m)
+	m.updateState()
+}
+
+// Effect returns the effect instance and true if the Mob has the effect. If
+// not found, an empty effect instance and false are returned.
+func (m *Mob) Effect(e effect.Type) (effect.Effect, bool) {
+	return m.effects.Effect(e)
+}
+
+// Effects returns all effects currently applied to the Mob.
+func (m *Mob) Effects() []effect.Effect {
+	return m.effects.Effects()
+}
+
+// Speed returns the movement speed of the Mob in blocks/tick.
+func (m *Mob) Speed() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.speed
+}
+
+// SetSpeed changes the movement speed of the Mob.
+func (m *Mob) SetSpeed(speed float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.speed = speed
+}
+
+// Armour returns the armour inventory of the Mob.
+func (m *Mob) Armour() *inventory.Armour {
+	return m.armour
+}
+
+// HeldItems returns the items currently held by the Mob in its main hand and
+// off-hand.
+func (m *Mob) HeldItems() (mainHand, offHand item.Stack) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mainHand, m.offHand
+}
+
+// SetHeldItems changes the items held by the Mob in its main hand and
+// off-hand.
+func (m *Mob) SetHeldItems(mainHand, offHand item.Stack) {
+	m.mu.Lock()
+	m.mainHand, m.offHand = mainHand, offHand
+	m.mu.Unlock()
+
+	for _, v := range m.viewers() {
+		v.ViewEntityItems(m)
+	}
+}
+
+// OnFireDuration returns the remaining duration that the Mob is on fire for.
+func (m *Mob) OnFireDuration() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fireDuration
+}
+
+// SetOnFire sets the Mob on fire for the duration passed. Mobs that are
+// immune to fire are never set on fire.
+func (m *Mob) SetOnFire(duration time.Duration) {
+	if duration < 0 || m.conf.FireImmune {
+		duration = 0
+	}
+	m.mu.Lock()
+	before, after := m.fireDuration > 0, duration > 0
+	m.fireDuration = duration
+	m.mu.Unlock()
+
+	if before != after {
+		m.updateState()
+	}
+}
+
+// Extinguish extinguishes the Mob.
+func (m *Mob) Extinguish() {
+	m.SetOnFire(0)
+}
+
+// AirSupply returns the remaining air supply of the Mob.
+func (m *Mob) AirSupply() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return time.Duration(m.airSupply) * time.Second / 20
+}
+
+// MaxAirSupply returns the maximum air supply of the Mob.
+func (m *Mob) MaxAirSupply() time.Duration {
+	return mobMaxAirSupply * time.Second / 20
+}
+
+// Breathing checks if the Mob is currently able to breathe.
+func (m *Mob) Breathing() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.airSupply >= mobMaxAirSupply
+}
+
+// FallDistance returns the distance that the Mob has currently been falling.
+func (m *Mob) FallDistance() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fallDistance
+}
+
+// ResetFallDistance resets the distance that the Mob has been falling.
+func (m *Mob) ResetFallDistance() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fallDistance = 0
+}
+
+// CollidedHorizontally checks if the Mob collided with a block horizontally
+// in the last tick.
+func (m *Mob) CollidedHorizontally() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.collidedHorizontal
+}
+
+// NameTag returns the name tag of the Mob. An empty string is returned if no
+// name tag was set.
+func (m *Mob) NameTag() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.name
+}
+
+// SetNameTag changes the name tag of the Mob. The name tag is removed if an
+// empty string is passed.
+func (m *Mob) SetNameTag(s string) {
+	m.mu.Lock()
+	m.name = s
+	m.mu.Unlock()
+	m.updateState()
+}
+
+// Tick ticks the Mob, applying its effects, fire, drowning and movement. Once
+// the Mob has died, Tick plays out its death animation, after which the Mob is
+// removed.
+func (m *Mob) Tick(w *world.World, current int64) {
+	if m.Dead() {
+		m.mu.Lock()
+		m.deathTicks++
+		remove := m.deathTicks >= 20
+		m.mu.Unlock()
+		if remove {
+			_ = m.Close()
+		}
+		return
+	}
+	m.mu.Lock()
+	m.immunity = max(m.immunity-time.Second/20, 0)
+	m.recentlyAttacked = max(m.recentlyAttacked-1, 0)
+	if m.recentlyAttacked == 0 {
+		m.lastAttacker = nil
+	}
+	m.age += time.Second / 20
+	m.mu.Unlock()
+
+	if m.Position()[1] < float64(w.Range()[0]) && current%10 == 0 {
+		m.Hurt(4, VoidDamageSource{})
+	}
+	m.effects.Tick(m)
+	m.tickAirSupply(w)
+	if !m.AttackImmune() && m.insideOfSolid(w) {
+		m.Hurt(1, SuffocationDamageSource{})
+	}
+	if d := m.OnFireDuration(); d > 0 {
+		d -= time.Second / 20
+		m.SetOnFire(d)
+		if w.RainingAt(cube.PosFromVec3(m.Position())) {
+			m.Extinguish()
+		} else if d%time.Second == 0 && !m.AttackImmune() {
+			m.Hurt(1, block.FireDamageSource{})
+		}
+	}
+	if m.Dead() {
+		return
+	}
+	if m.conf.Behaviour != nil {
+		m.conf.Behaviour.Tick(m)
+		if m.World() == nil {
+			// The behaviour removed the Mob from the world, for example
+			// because it exploded.
+			return
+		}
+	}
+	m.tickMovement(w)
+}
+
+// tickMovement moves the Mob using its velocity, applying water drag, fall
+// damage and the effects of the blocks that the Mob is inside of.
+func (m *Mob) tickMovement(w *world.World) {
+	m.mu.Lock()
+	pos, vel, rot := m.pos, m.vel, m.rot
+	m.mu.Unlock()
+
+	inWater := m.insideOfLiquid(w, pos)
+	switch {
+	case inWater && m.conf.Aquatic:
+		m.mc.Gravity, m.mc.Drag = 0, 0.1
+	case inWater:
+		m.mc.Gravity, m.mc.Drag = 0.02, 0.2
+	default:
+		m.mc.Gravity, m.mc.Drag = 0.08, 0.02
+	}
+	mv := m.mc.TickMovement(m, pos, vel, rot)
+
+	m.mu.Lock()
+	m.pos, m.vel = mv.pos, mv.vel
+	// The MovementComputer cancels velocity on an axis if the Mob collided
+	// with a block on that axis.
+	m.collidedHorizontal = (vel[0] != 0 && mv.vel[0] == 0) || (vel[2] != 0 && mv.vel[2] == 0)
+	fallDistance := m.fallDistance
+	if inWater {
+		m.fallDistance = 0
+	} else if mv.dpos[1] < 0 {
+		m.fallDistance -= mv.dpos[1]
+	}
+	rotChanged := m.viewedRot != rot
+	m.viewedRot = rot
+	m.mu.Unlock()
+	mv.Send()
+	if rotChanged && mv.dpos.ApproxEqualThreshold(zeroVec3, epsilon) {
+		// The Mob only turned around, which is not sent to viewers by the
+		// Movement.
+		for _, v := range mv.v {
+			v.ViewEntityMovement(m, mv.pos, rot, mv.onGround)
+		}
+	}
+
+	if m.mc.OnGround() && fallDistance > 0 {
+		m.ResetFallDistance()
+		m.fall(w, fallDistance)
+	}
+	m.checkEntityInsiders(w)
+}
+
+// fall is called when the Mob hits the ground after falling the distance
+// passed. Fall damage is dealt if the Mob fell far enough.
+func (m *Mob) fall(w *world.World, distance float64) {
+	pos := cube.PosFromVec3(m.Position())
+	b := w.Block(pos)
+	if len(b.Model().BBox(pos, w)) == 0 {
+		pos = pos.Side(cube.FaceDown)
+		b = w.Block(pos)
+	}
+	if h, ok := b.(block.EntityLander); ok {
+		h.EntityLand(pos, w, m, &distance)
+	}
+	dmg := distance - 3
+	if boost, ok := m.Effect(effect.JumpBoost{}); ok {
+		dmg -= float64(boost.Level())
+	}
+	if dmg < 0.5 {
+		return
+	}
+	m.Hurt(math.Ceil(dmg), FallDamageSource{})
+}
+
+// checkEntityInsiders calls EntityInside on all blocks and liquids that the
+// Mob is currently inside of.
+func (m *Mob) checkEntityInsiders(w *world.World) {
+	box := m.t.BBox(m).Translate(m.Position()).Grow(-0.0001)
+	low, high := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+
+	for y := low[1]; y <= high[1]; y++ {
+		for x := low[0]; x <= high[0]; x++ {
+			for z := low[2]; z <= high[2]; z++ {
+				pos := cube.Pos{x, y, z}
+				b := w.Block(pos)
+				if insider, ok := b.(block.EntityInsider); ok {
+					insider.EntityInside(pos, w, m)
+					if _, liquid := b.(world.Liquid); liquid {
+						continue
+					}
+				}
+				if l, ok := w.Liquid(pos); ok {
+					if insider, ok := l.(block.EntityInsider); ok {
+						insider.EntityInside(pos, w, m)
+					}
+				}
+			}
+		}
+	}
+}
+
+// tickAirSupply consumes the air supply of the Mob while its head is under
+// water, dealing drowning damage once it runs out. Aquatic Mobs instead
+// consume their air supply while out of water.
+func (m *Mob) tickAirSupply(w *world.World) {
+	var drowning bool
+	if m.conf.Aquatic {
+		drowning = !m.insideOfLiquid(w, m.Position())
+	} else {
+		_, waterBreathing := m.Effect(effect.WaterBreathing{})
+		drowning = !waterBreathing && m.insideOfLiquid(w, EyePosition(m))
+	}
+
+	m.mu.Lock()
+	before := m.airSupply
+	switch {
+	case drowning:
+		m.airSupply--
+	case m.airSupply < mobMaxAirSupply:
+		m.airSupply = min(m.airSupply+5, mobMaxAirSupply)
+	}
+	hurt := m.airSupply <= -20
+	if hurt {
+		m.airSupply = 0
+	}
+	changed := before != m.airSupply
+	m.mu.Unlock()
+
+	if hurt && !m.AttackImmune() {
+		m.Hurt(2, DrowningDamageSource{})
+	}
+	if changed {
+		m.updateState()
+	}
+}
+
+// insideOfLiquid checks if the position passed is inside of water.
+func (m *Mob) insideOfLiquid(w *world.World, pos mgl64.Vec3) bool {
+	bpos := cube.PosFromVec3(pos)
+	if l, ok := w.Liquid(bpos); ok {
+		if _, water := l.(block.Water); water {
+			return pos[1] < float64(bpos[1])+float64(l.LiquidDepth())/9
+		}
+	}
+	return false
+}
+
+// insideOfSolid checks if the eyes of the Mob are inside a solid block.
+func (m *Mob) insideOfSolid(w *world.World) bool {
+	pos := cube.PosFromVec3(EyePosition(m))
+	b := w.Block(pos)
+	if _, solid := b.Model().(model.Solid); !solid {
+		return false
+	}
+	if d, ok := b.(block.LightDiffuser); ok && d.LightDiffusionLevel() == 0 {
+		return false
+	}
+	return true
+}
+
+// kill plays the death animation of the Mob and drops its items and
+// experience.
+func (m *Mob) kill(src world.DamageSource) {
+	for _, v := range m.viewers() {
+		v.ViewEntityAction(m, DeathAction{})
+	}
+	m.Extinguish()
+	for _, e := range m.Effects() {
+		m.RemoveEffect(e.Type())
+	}
+
+	w, pos := m.World(), m.Position()
+	var drops []item.Stack
+	if table, ok := loot.Lookup(loot.EntityTable(m.Type().EncodeEntity())); ok {
+		drops = table.Generate(lootContext(src))
+	} else if m.conf.Drops != nil {
+		drops = m.conf.Drops(m, src)
+	}
+	mainHand, offHand := m.HeldItems()
+	for _, it := range append(m.armour.Items(), mainHand, offHand) {
+		// Equipment is only dropped occasionally, similarly to equipment
+		// that mobs spawn with in vanilla.
+		if !it.Empty() && rand.Float64() < 0.085 {
+			drops = append(drops, it)
+		}
+	}
+	for _, it := range drops {
+		if !it.Empty() {
+			w.AddEntity(NewItem(it, pos))
+		}
+	}
+
+	m.mu.Lock()
+	attacked := m.recentlyAttacked > 0
+	m.mu.Unlock()
+	if attacked && m.conf.Experience != nil {
+		for _, orb := range NewExperienceOrbs(pos, m.conf.Experience(m)) {
+			w.AddEntity(orb)
+		}
+	}
+}
+
+// damageItem damages the item stack passed with the damage passed, taking
+// into account the Unbreaking enchantment.
+func (m *Mob) damageItem(s item.Stack, d int) item.Stack {
+	if d == 0 || s.MaxDurability() == -1 {
+		return s
+	}
+	if e, ok := s.Enchantment(enchantment.Unbreaking{}); ok {
+		d = (enchantment.Unbreaking{}).Reduce(s.Item(), e.Level(), d)
+	}
+	if s = s.Damage(d); s.Empty() {
+		m.World().PlaySound(m.Position(), sound.ItemBreak{})
+	}
+	return s
+}
+
+// updateState updates the state of the Mob, such as its health, to all
+// viewers of the Mob.
+func (m *Mob) updateState() {
+	for _, v := range m.viewers() {
+		v.ViewEntityState(m)
+	}
+}
+
+// viewers returns all viewers of the Mob.
+func (m *Mob) viewers() []world.Viewer {
+	return m.World().Viewers(m.Position())
+}
+
+// Close removes the Mob from the world.
+func (m *Mob) Close() error {
+	m.World().RemoveEntity(m)
+	return nil
+}
+
+// decodeMob decodes the properties shared by all mobs from the NBT map passed
+// into the Mob m. It is used by the SaveableEntityType implementations of
+// mobs.
+func decodeMob(m *Mob, data map[string]any) *Mob {
+	m.vel = nbtconv.Vec3(data, "Motion")
+	m.rot = nbtconv.Rotation(data)
+	m.name = nbtconv.String(data, "CustomName")
+	m.fireDuration = nbtconv.TickDuration[int16](data, "Fire")
+	m.fallDistance = float64(nbtconv.Float32(data, "FallDistance"))
+	if uniqueID, ok := data["UniqueID"].(int64); ok {
+		m.uniqueID = uniqueID
+	}
+	if air, ok := data["Air"].(int16); ok {
+		m.airSupply = int64(air)
+	}
+	if health, ok := data["Health"].(float32); ok {
+		m.health.AddHealth(float64(health) - m.health.Health())
+	}
+	if armour := nbtconv.Slice[any](data, "Armor"); len(armour) == 4 {
+		stacks := make([]item.Stack, 4)
+		for i, s := range armour {
+			if s, ok := s.(map[string]any); ok {
+				stacks[i] = nbtconv.Item(s, nil)
+			}
+		}
+		m.armour.Set(stacks[0], stacks[1], stacks[2], stacks[3])
+	}
+	if mainHand := nbtconv.Slice[any](data, "Mainhand"); len(mainHand) == 1 {
+		if s, ok := mainHand[0].(map[string]any); ok {
+			m.mainHand = nbtconv.Item(s, nil)
+		}
+	}
+	if offHand := nbtconv.Slice[any](data, "Offhand"); len(offHand) == 1 {
+		if s, ok := offHand[0].(map[string]any); ok {
+			m.offHand = nbtconv.Item(s, nil)
+		}
+	}
+	for _, e := range nbtconv.Slice[any](data, "ActiveEffects") {
+		if e, ok := e.(map[string]any); ok {
+			t, ok := effect.ByID(int(nbtconv.Uint8(e, "Id")))
+			lasting, lastingOk := t.(effect.LastingType)
+			if !ok || !lastingOk {
+				continue
+			}
+			eff := effect.New(lasting, int(nbtconv.Uint8(e, "Amplifier"))+1, nbtconv.TickDuration[int32](e, "Duration"))
+			if nbtconv.Bool(e, "Ambient") {
+				eff = effect.NewAmbient(lasting, eff.Level(), eff.Duration())
+			}
+			if !nbtconv.Bool(e, "ShowParticles") {
+				eff = eff.WithoutParticles()
+			}
+			m.effects.Add(eff, m)
+		}
+	}
+	return m
+}
+
+// encodeMob encodes the properties shared by all mobs into an NBT map. It is
+// used by the SaveableEntityType implementations of mobs.
+func encodeMob(m *Mob) map[string]any {
+	yaw, pitch := m.Rotation().Elem()
+	mainHand, offHand := m.HeldItems()
+	armour := m.armour
+	effects := make([]map[string]any, 0)
+	for _, e := range m.Effects() {
+		id, ok := effect.ID(e.Type())
+		if !ok {
+			continue
+		}
+		effects = append(effects, map[string]any{
+			"Id":            uint8(id),
+			"Amplifier":     uint8(e.Level() - 1),
+			"Duration":      int32(e.Duration().Milliseconds() / 50),
+			"Ambient":       e.Ambient(),
+			"ShowParticles": !e.ParticlesHidden(),
+		})
+	}
+	return map[string]any{
+		"UniqueID":     m.uniqueID,
+		"Pos":          nbtconv.Vec3ToFloat32Slice(m.Position()),
+		"Motion":       nbtconv.Vec3ToFloat32Slice(m.Velocity()),
+		"Yaw":          float32(yaw),
+		"Pitch":        float32(pitch),
+		"CustomName":   m.NameTag(),
+		"Health":       float32(m.Health()),
+		"Fire":         int16(m.OnFireDuration().Milliseconds() / 50),
+		"Air":          int16(m.AirSupply().Milliseconds() / 50),
+		"FallDistance": float32(m.FallDistance()),
+		"Armor": []map[string]any{
+			mobItemToNBT(armour.Helmet()),
+			mobItemToNBT(armour.Chestplate()),
+			mobItemToNBT(armour.Leggings()),
+			mobItemToNBT(armour.Boots()),
+		},
+		"Mainhand":      []map[string]any{mobItemToNBT(mainHand)},
+		"Offhand":       []map[string]any{mobItemToNBT(offHand)},
+		"ActiveEffects": effects,
+	}
+}
+
+// mobItemToNBT encodes an item stack of the equipment of a mob so that it may
+// be saved. Empty item stacks are encoded as an item without a name.
+func mobItemToNBT(s item.Stack) map[string]any {
+	if s.Empty() {
+		return map[string]any{"Name": "", "Count": byte(0)}
+	}
+	return nbtconv.WriteItem(s, true)
+}
+
+// lootContext returns the loot.Context used to generate the loot of a Mob
+// killed by the world.DamageSource passed.
+func lootContext(src world.DamageSource) loot.Context {
+	return loot.Context{Tool: killerHeldItem(src), Looting: lootingLevel(src), KilledByPlayer: killedByPlayer(src)}
+}
+
+// killerHeldItem returns the item held in the main hand of the entity that
+// caused the world.DamageSource passed. An empty stack is returned if no
+// entity caused it or if the entity cannot hold items.
+func killerHeldItem(src world.DamageSource) item.Stack {
+	if h, ok := killer(src).(interface {
+		HeldItems() (item.Stack, item.Stack)
+	}); ok {
+		held, _ := h.HeldItems()
+		return held
+	}
+	return item.Stack{}
+}
+
+// lootingLevel returns the level of the Looting enchantment of the item held
+// by the entity that caused the world.DamageSource passed, or 0 if it is not
+// enchanted with Looting.
+func lootingLevel(src world.DamageSource) int {
+	if e, ok := killerHeldItem(src).Enchantment(enchantment.Looting{}); ok {
+		return e.Level()
+	}
+	return 0
+}
+
+// lootingBonus returns a random amount of additional items of a single type
+// dropped by a Mob killed by the world.DamageSource passed, depending on the
+// level of Looting used.
+func lootingBonus(src world.DamageSource) int {
+	return enchantment.Looting{}.Bonus(lootingLevel(src))
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/entity/pathfind"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// MonsterBehaviourConfig holds optional parameters for a MonsterBehaviour.
+type MonsterBehaviourConfig struct {
+	// BurnsInDaylight specifies if the monster is set on fire when it is in
+	// direct sunlight without wearing a helmet, like zombies and skeletons.
+	BurnsInDaylight bool
+	// Goals is called when the ai.Brain of the monster is created and is used
+	// to add the goals used to attack its target, in addition to the default
+	// goals of monsters.
+	Goals func(m *Mob, b *ai.Brain)
+	// Targeting is an optional function that must return true for the monster
+	// to start targeting the nearest player. Monsters always target entities
+	// that attack them.
+	Targeting func(m *Mob) bool
+}
+
+// New creates a MonsterBehaviour using the parameters in conf.
+func (conf MonsterBehaviourConfig) New() *MonsterBehaviour {
+	return &MonsterBehaviour{conf: conf}
+}
+
+// MonsterBehaviour implements the behaviour shared by hostile monsters. They
+// target the nearest player in survival or adventure mode and entities that
+// attack them. Monsters are removed from worlds with the peaceful difficulty.
+type MonsterBehaviour struct {
+	conf  MonsterBehaviourConfig
+	brain *ai.Brain
+}
+
+// Brain returns the ai.Brain of the monster. Nil is returned if the monster
+// was not ticked yet.
+func (mb *MonsterBehaviour) Brain() *ai.Brain {
+	return mb.brain
+}
+
+// Tick ticks the AI of the monster and sets it on fire in daylight.
+func (mb *MonsterBehaviour) Tick(m *Mob) {
+	w := m.World()
+	if w.Difficulty() == world.DifficultyPeaceful {
+		_ = m.Close()
+		return
+	}
+	if mb.brain == nil {
+		mb.brain = mb.newBrain(m)
+	}
+	if mb.conf.BurnsInDaylight && inDaylight(m) && m.OnFireDuration() <= 0 {
+		if helmet := m.Armour().Helmet(); helmet.Empty() {
+			m.SetOnFire(time.Second * 8)
+		}
+	}
+	mb.brain.Tick()
+}
+
+// newBrain creates the ai.Brain of the monster with the goals shared by all
+// monsters.
+func (mb *MonsterBehaviour) newBrain(m *Mob) *ai.Brain {
+	bb := m.Type().BBox(m)
+	b := ai.NewBrain(m, pathfind.Config{Width: bb.Width(), Height: bb.Height()})
+	b.AddSensor(ai.NearestPlayerSensor{Range: 35})
+	b.AddSensor(ai.AttackerSensor{})
+
+	b.AddGoal(0, &ai.Float{})
+	b.AddGoal(1, &ai.TargetAttacker{})
+	b.AddGoal(2, &ai.TargetNearestPlayer{Range: 35, Condition: func(*ai.Brain) bool {
+		return mb.conf.Targeting == nil || mb.conf.Targeting(m)
+	}})
+	if mb.conf.Goals != nil {
+		mb.conf.Goals(m, b)
+	}
+	b.AddGoal(7, &ai.Wander{})
+	b.AddGoal(8, &ai.LookAtPlayer{})
+	return b
+}
+
+// inDaylight checks if the Mob passed is in direct sunlight during the day.
+func inDaylight(m *Mob) bool {
+	w := m.World()
+	if t := w.Time() % 24000; t > 12542 && t < 23460 {
+		return false
+	}
+	pos := cube.PosFromVec3(EyePosition(m))
+	if m.insideOfLiquid(w, m.Position().Add(mgl64.Vec3{0, 0.1})) || w.RainingAt(pos) {
+		return false
+	}
+	return w.SkyLight(pos) == 15
+}
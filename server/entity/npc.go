@@ -0,0 +1,255 @@
+package entity
+
+import (
+	"math"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player/skin"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+)
+
+// NPCBehaviourConfig holds settings that influence the way an NPCBehaviour
+// operates. NPCBehaviourConfig.New() may be called to create a new behaviour
+// with this config.
+type NPCBehaviourConfig struct {
+	// Skin is the skin that the NPC is rendered with.
+	Skin skin.Skin
+	// ScoreTag is an optional line of text shown below the name tag of the
+	// NPC.
+	ScoreTag string
+	// LookDistance is the distance in blocks within which the NPC turns its
+	// head towards the nearest player. If LookDistance is 0, the NPC keeps the
+	// rotation it was spawned with.
+	LookDistance float64
+	// Interact is called when a player clicks the NPC, either by attacking it
+	// or by interacting with it. Interact is not saved with the NPC and must
+	// be set again using NPCBehaviour.Handle after the NPC is loaded.
+	Interact func(e *Ent, user item.User)
+}
+
+// New creates an NPCBehaviour using the settings provided in conf.
+func (conf NPCBehaviourConfig) New() *NPCBehaviour {
+	return &NPCBehaviour{conf: conf, id: uuid.New()}
+}
+
+// NewNPC creates a new human NPC with the name tag and position passed. The
+// NPC is rendered like a player using the skin in conf, but it is never shown
+// in the player list.
+func NewNPC(name string, pos mgl64.Vec3, conf NPCBehaviourConfig) *Ent {
+	e := Config{Behaviour: conf.New()}.New(NPCType{}, pos)
+	e.name = name
+	return e
+}
+
+// NPCBehaviour implements the behaviour of a human NPC. NPCs are unable to
+// move or be damaged, but may turn to look at nearby players and report
+// clicks to a callback.
+type NPCBehaviour struct {
+	id uuid.UUID
+
+	// mu guards the ScoreTag and Interact fields of conf, which may be
+	// changed while the NPC is in a world.
+	mu   sync.Mutex
+	conf NPCBehaviourConfig
+}
+
+// UUID returns the UUID that the NPC is rendered with.
+func (n *NPCBehaviour) UUID() uuid.UUID {
+	return n.id
+}
+
+// Skin returns the skin of the NPC.
+func (n *NPCBehaviour) Skin() skin.Skin {
+	return n.conf.Skin
+}
+
+// ScoreTag returns the score tag of the NPC. An empty string is returned if
+// the NPC has no score tag.
+func (n *NPCBehaviour) ScoreTag() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.conf.ScoreTag
+}
+
+// SetScoreTag changes the score tag of the NPC and sends it to viewers.
+func (n *NPCBehaviour) SetScoreTag(e *Ent, s string) {
+	n.mu.Lock()
+	n.conf.ScoreTag = s
+	n.mu.Unlock()
+	for _, v := range e.World().Viewers(e.Position()) {
+		v.ViewEntityState(e)
+	}
+}
+
+// Handle changes the function called when a player clicks the NPC. Passing
+// nil removes the function.
+func (n *NPCBehaviour) Handle(f func(e *Ent, user item.User)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.conf.Interact = f
+}
+
+// interact returns the function called when a player clicks the NPC, or nil
+// if no such function is set.
+func (n *NPCBehaviour) interact() func(e *Ent, user item.User) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.conf.Interact
+}
+
+// Tick turns the NPC towards the nearest player within its LookDistance.
+// NPCs never move, so nil is always returned.
+func (n *NPCBehaviour) Tick(e *Ent) *Movement {
+	if n.conf.LookDistance <= 0 {
+		return nil
+	}
+	w, pos := e.World(), e.Position()
+	eye := pos.Add(mgl64.Vec3{0, 1.62})
+
+	var (
+		target  world.Entity
+		closest = n.conf.LookDistance
+	)
+	for _, other := range w.EntitiesWithin(cube.Box(-1, -1, -1, 1, 1, 1).Grow(n.conf.LookDistance).Translate(pos), nil) {
+		if _, ok := other.(interface{ GameMode() world.GameMode }); !ok {
+			continue
+		}
+		if dist := other.Position().Sub(pos).Len(); dist <= closest {
+			target, closest = other, dist
+		}
+	}
+	if target == nil {
+		return nil
+	}
+	diff := EyePosition(target).Sub(eye)
+	rot := cube.Rotation{
+		mgl64.RadToDeg(math.Atan2(-diff[0], diff[2])),
+		-mgl64.RadToDeg(math.Atan2(diff[1], math.Hypot(diff[0], diff[2]))),
+	}
+
+	e.mu.Lock()
+	changed := !mgl64.FloatEqualThreshold(e.rot.Yaw(), rot.Yaw(), 1) || !mgl64.FloatEqualThreshold(e.rot.Pitch(), rot.Pitch(), 1)
+	e.rot = rot
+	e.mu.Unlock()
+
+	if changed {
+		for _, v := range w.Viewers(pos) {
+			v.ViewEntityMovement(e, pos, rot, true)
+		}
+	}
+	return nil
+}
+
+// Interact calls the Interact function of the NPC, if set.
+func (n *NPCBehaviour) Interact(e *Ent, user item.User) bool {
+	f := n.interact()
+	if f == nil {
+		return false
+	}
+	f(e, user)
+	return true
+}
+
+// Damage calls the Interact function of the NPC if it was attacked by a
+// player. NPCs never take damage, so false is always returned.
+func (n *NPCBehaviour) Damage(e *Ent, _ float64, src world.DamageSource) bool {
+	if s, ok := src.(AttackDamageSource); ok {
+		if user, ok := s.Attacker.(item.User); ok {
+			if f := n.interact(); f != nil {
+				f(e, user)
+			}
+		}
+	}
+	return false
+}
+
+// Immobile always returns true.
+func (n *NPCBehaviour) Immobile() bool {
+	return true
+}
+
+// NPCType is a world.EntityType implementation for human NPCs.
+type NPCType struct{}
+
+func (NPCType) EncodeEntity() string   { return "dragonfly:npc" }
+func (NPCType) NetworkOffset() float64 { return 1.62 }
+func (NPCType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.8, 0.3)
+}
+
+func (NPCType) DecodeNBT(m map[string]any) world.Entity {
+	conf := NPCBehaviourConfig{
+		ScoreTag:     nbtconv.String(m, "ScoreTag"),
+		LookDistance: float64(nbtconv.Float32(m, "LookDistance")),
+	}
+	if s, ok := m["Skin"].(map[string]any); ok {
+		conf.Skin = decodeSkin(s)
+	}
+	e := NewNPC(nbtconv.String(m, "CustomName"), nbtconv.Vec3(m, "Pos"), conf)
+	e.rot = nbtconv.Rotation(m)
+	if uniqueID, ok := m["UniqueID"].(int64); ok {
+		e.uniqueID = uniqueID
+	}
+	if id, err := uuid.Parse(nbtconv.String(m, "UUID")); err == nil {
+		e.conf.Behaviour.(*NPCBehaviour).id = id
+	}
+	return e
+}
+
+func (NPCType) EncodeNBT(e world.Entity) map[string]any {
+	ent := e.(*Ent)
+	n := ent.conf.Behaviour.(*NPCBehaviour)
+	yaw, pitch := ent.Rotation().Elem()
+	return map[string]any{
+		"UniqueID":     ent.uniqueID,
+		"UUID":         n.id.String(),
+		"Pos":          nbtconv.Vec3ToFloat32Slice(ent.Position()),
+		"Yaw":          float32(yaw),
+		"Pitch":        float32(pitch),
+		"CustomName":   ent.NameTag(),
+		"ScoreTag":     n.ScoreTag(),
+		"LookDistance": float32(n.conf.LookDistance),
+		"Skin":         encodeSkin(n.conf.Skin),
+	}
+}
+
+// encodeSkin encodes a skin.Skin into an NBT map. Animations of the skin are
+// not encoded.
+func encodeSkin(s skin.Skin) map[string]any {
+	return map[string]any{
+		"Width":       int32(s.Bounds().Dx()),
+		"Height":      int32(s.Bounds().Dy()),
+		"Data":        nbtconv.ByteArray(s.Pix),
+		"Persona":     boolByte(s.Persona),
+		"PlayFabID":   s.PlayFabID,
+		"ModelConfig": nbtconv.ByteArray(s.ModelConfig.Encode()),
+		"Model":       nbtconv.ByteArray(s.Model),
+		"CapeWidth":   int32(s.Cape.Bounds().Dx()),
+		"CapeHeight":  int32(s.Cape.Bounds().Dy()),
+		"CapeData":    nbtconv.ByteArray(s.Cape.Pix),
+	}
+}
+
+// decodeSkin decodes a skin.Skin from an NBT map created using encodeSkin.
+func decodeSkin(m map[string]any) skin.Skin {
+	s := skin.New(int(nbtconv.Int32(m, "Width")), int(nbtconv.Int32(m, "Height")))
+	if data := nbtconv.Bytes(m, "Data"); len(data) == len(s.Pix) {
+		copy(s.Pix, data)
+	}
+	s.Persona, s.PlayFabID = nbtconv.Bool(m, "Persona"), nbtconv.String(m, "PlayFabID")
+	if data := nbtconv.Bytes(m, "ModelConfig"); len(data) > 0 {
+		s.ModelConfig, _ = skin.DecodeModelConfig(data)
+	}
+	s.Model = nbtconv.Bytes(m, "Model")
+
+	s.Cape = skin.NewCape(int(nbtconv.Int32(m, "CapeWidth")), int(nbtconv.Int32(m, "CapeHeight")))
+	if data := nbtconv.Bytes(m, "CapeData"); len(data) == len(s.Cape.Pix) {
+		copy(s.Cape.Pix, data)
+	}
+	return s
+}
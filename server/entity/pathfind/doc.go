@@ -0,0 +1,4 @@
+// Package pathfind implements A* path finding over the blocks of a world. A
+// Search finds a Path over a limited number of nodes every tick, and a
+// Navigator makes an entity follow the Path found by setting its velocity.
+package pathfind
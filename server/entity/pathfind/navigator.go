@@ -0,0 +1,257 @@
+package pathfind
+
+import (
+	"math"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Entity is an entity that is able to follow a Path using a Navigator. Its
+// movement is computed by an entity.MovementComputer using the velocity set
+// by the Navigator.
+type Entity interface {
+	world.Entity
+	// Velocity returns the current velocity of the entity.
+	Velocity() mgl64.Vec3
+	// SetVelocity sets the velocity of the entity.
+	SetVelocity(v mgl64.Vec3)
+	// SetRotation changes the rotation of the entity.
+	SetRotation(r cube.Rotation)
+	// OnGround checks if the entity is currently standing on the ground.
+	OnGround() bool
+	// Speed returns the base movement speed of the entity in blocks per tick.
+	Speed() float64
+}
+
+// Navigator moves an Entity towards a target by searching a Path and
+// following it. Navigator is not safe for concurrent use: it should only be
+// used from the goroutine that ticks the Entity.
+type Navigator struct {
+	conf Config
+
+	target     cube.Pos
+	multiplier float64
+	navigating bool
+
+	search *Search
+	path   *Path
+
+	door       cube.Pos
+	doorOpened bool
+
+	lastPos    mgl64.Vec3
+	stuckTicks int
+
+	// partialEnd is the position that the last partial Path found led to, if
+	// partial is true. retryTicks is the number of ticks left before a new
+	// Path may be searched after following a partial Path.
+	partialEnd cube.Pos
+	partial    bool
+	retryTicks int
+}
+
+// retryDelay is the number of ticks that a Navigator waits before searching a
+// new Path after a partial Path did not lead to its target.
+const retryDelay = 60
+
+// NewNavigator returns a Navigator that searches Paths using the Config
+// passed.
+func NewNavigator(conf Config) *Navigator {
+	return &Navigator{conf: conf.withDefaults()}
+}
+
+// NavigateTo makes the Navigator start moving its Entity towards the target
+// passed. The speed passed is multiplied with the speed of the Entity. If the
+// Navigator was already moving towards the same target, only the speed is
+// updated.
+func (n *Navigator) NavigateTo(target cube.Pos, speed float64) {
+	n.multiplier = speed
+	if n.navigating && n.target == target {
+		return
+	}
+	n.target, n.navigating = target, true
+	n.search, n.path, n.stuckTicks = nil, nil, 0
+	n.partial = false
+}
+
+// Stop makes the Navigator stop moving towards its target.
+func (n *Navigator) Stop() {
+	n.navigating = false
+	n.search, n.path = nil, nil
+}
+
+// Navigating checks if the Navigator is currently moving towards a target.
+func (n *Navigator) Navigating() bool {
+	return n.navigating
+}
+
+// Config returns the Config that the Navigator searches Paths with.
+func (n *Navigator) Config() Config {
+	return n.conf
+}
+
+// Path returns the Path that the Navigator is currently following. If no
+// Path was found yet, nil is returned.
+func (n *Navigator) Path() *Path {
+	return n.path
+}
+
+// Tick continues the search for a Path and moves the Entity passed along the
+// Path found. Tick should be called every tick, before the movement of the
+// Entity is computed.
+func (n *Navigator) Tick(e Entity) {
+	w := e.World()
+	if w == nil {
+		return
+	}
+	n.closeDoor(w, e)
+	if n.retryTicks > 0 {
+		n.retryTicks--
+	}
+	if !n.navigating {
+		return
+	}
+	pos := e.Position()
+	if n.path == nil {
+		if n.retryTicks > 0 {
+			return
+		}
+		if n.search == nil {
+			n.search = n.conf.NewSearch(cube.PosFromVec3(pos), n.target)
+		}
+		path, ok := n.search.Tick(w)
+		if !ok {
+			return
+		}
+		n.search = nil
+		if end, ok := path.Target(); ok && path.Partial() {
+			if n.partial && end.Pos == n.partialEnd {
+				// The new Path leads to the same position as the last one, so
+				// there is no use in following it: wait before trying again.
+				n.retryTicks = retryDelay
+				return
+			}
+			n.partialEnd, n.partial = end.Pos, true
+		}
+		n.path = path
+		n.lastPos, n.stuckTicks = pos, 0
+	}
+
+	node, ok := n.path.Current()
+	for ok && n.reached(pos, node) {
+		n.path.Advance()
+		node, ok = n.path.Current()
+	}
+	if !ok {
+		n.halt(e)
+		if n.path.Partial() && cube.PosFromVec3(pos) != n.target {
+			// The target could not be reached the last time: try again from
+			// the position the Entity got to after some time.
+			n.path, n.retryTicks = nil, retryDelay
+			return
+		}
+		n.Stop()
+		return
+	}
+
+	if pos.Sub(n.lastPos).Len() < 0.01 {
+		n.stuckTicks++
+	} else {
+		n.stuckTicks = 0
+	}
+	n.lastPos = pos
+	if n.stuckTicks > 60 {
+		// The Entity has not moved for some time, which usually means the
+		// world changed since the Path was searched.
+		n.halt(e)
+		n.path = nil
+		return
+	}
+
+	if node.Kind == NodeDoor {
+		n.openDoor(w, node.Pos)
+	}
+	n.move(e, pos, node)
+}
+
+// reached checks if an entity at the position passed has reached the Node
+// passed.
+func (n *Navigator) reached(pos mgl64.Vec3, node Node) bool {
+	centre := node.Vec3()
+	dx, dz := pos[0]-centre[0], pos[2]-centre[2]
+	return dx*dx+dz*dz < 0.3*0.3 && math.Abs(pos[1]-node.Floor) < 1
+}
+
+// move sets the velocity and rotation of the Entity so that it moves towards
+// the Node passed.
+func (n *Navigator) move(e Entity, pos mgl64.Vec3, node Node) {
+	diff := node.Vec3().Sub(pos)
+	horizontal := mgl64.Vec2{diff[0], diff[2]}
+	speed := e.Speed() * n.multiplier
+
+	vel := e.Velocity()
+	if l := horizontal.Len(); l > 0 {
+		v := horizontal.Mul(math.Min(speed, l) / l)
+		vel[0], vel[2] = v[0], v[1]
+	}
+	if node.Kind == NodeSwim || n.inWater(e.World(), pos) {
+		if diff[1] > -0.1 {
+			vel[1] = math.Max(vel[1], 0.04)
+		}
+	} else if diff[1] > n.conf.StepHeight && e.OnGround() {
+		vel[1] = 0.42
+	}
+	e.SetVelocity(vel)
+
+	yaw := mgl64.RadToDeg(math.Atan2(-diff[0], diff[2]))
+	e.SetRotation(cube.Rotation{yaw, 0})
+}
+
+// halt cancels the horizontal velocity of the Entity passed.
+func (n *Navigator) halt(e Entity) {
+	vel := e.Velocity()
+	e.SetVelocity(mgl64.Vec3{0, vel[1], 0})
+}
+
+// inWater checks if the block at the position passed holds water.
+func (n *Navigator) inWater(w *world.World, pos mgl64.Vec3) bool {
+	l, ok := w.Liquid(cube.PosFromVec3(pos))
+	if !ok {
+		return false
+	}
+	_, ok = l.(block.Water)
+	return ok
+}
+
+// openDoor opens the closed wooden door at the position passed, so that the
+// Entity can move through it. The door is closed again once the Entity has
+// moved through it.
+func (n *Navigator) openDoor(w *world.World, pos cube.Pos) {
+	for _, p := range [...]cube.Pos{pos, pos.Side(cube.FaceUp)} {
+		if d, ok := w.Block(p).(block.WoodDoor); ok {
+			if !d.Open {
+				d.Activate(p, cube.FaceUp, w, nil, nil)
+				n.door, n.doorOpened = p, true
+			}
+			return
+		}
+	}
+}
+
+// closeDoor closes the door opened by the Navigator once the Entity has moved
+// away from it.
+func (n *Navigator) closeDoor(w *world.World, e Entity) {
+	if !n.doorOpened {
+		return
+	}
+	if e.Position().Sub(n.door.Vec3Centre()).Len() < 1.5 {
+		return
+	}
+	n.doorOpened = false
+	if d, ok := w.Block(n.door).(block.WoodDoor); ok && d.Open {
+		d.Activate(n.door, cube.FaceUp, w, nil, nil)
+	}
+}
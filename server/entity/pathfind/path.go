@@ -0,0 +1,95 @@
+package pathfind
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NodeKind specifies the way that an entity moves through a Node.
+type NodeKind uint8
+
+const (
+	// NodeWalk is a Node that an entity walks over.
+	NodeWalk NodeKind = iota
+	// NodeSwim is a Node in water that an entity swims through.
+	NodeSwim
+	// NodeDoor is a Node with a closed door that an entity must open to move
+	// through.
+	NodeDoor
+)
+
+// Node is a single position on a Path.
+type Node struct {
+	// Pos is the block position that the feet of the entity are in when it is
+	// at the Node.
+	Pos cube.Pos
+	// Floor is the Y coordinate of the surface that the entity stands on at
+	// the Node. For swimming nodes, Floor is the bottom of the block.
+	Floor float64
+	// Kind is the way that the entity moves through the Node.
+	Kind NodeKind
+}
+
+// Vec3 returns the position that an entity moves towards to reach the Node:
+// the centre of the block at the height of its floor.
+func (n Node) Vec3() mgl64.Vec3 {
+	return mgl64.Vec3{float64(n.Pos[0]) + 0.5, n.Floor, float64(n.Pos[2]) + 0.5}
+}
+
+// Path is a sequence of Nodes that leads an entity from one position to
+// another. A Path is created by a Search.
+type Path struct {
+	nodes   []Node
+	index   int
+	partial bool
+}
+
+// Nodes returns all Nodes of the Path, including those already passed.
+func (p *Path) Nodes() []Node {
+	return p.nodes
+}
+
+// Partial checks if the Path does not lead to the target of the Search that
+// created it, but to the position closest to the target that was found.
+func (p *Path) Partial() bool {
+	return p.partial
+}
+
+// Current returns the Node that the entity following the Path is currently
+// moving towards. False is returned if the Path was finished.
+func (p *Path) Current() (Node, bool) {
+	if p.Finished() {
+		return Node{}, false
+	}
+	return p.nodes[p.index], true
+}
+
+// Previous returns the Node that the entity following the Path passed last.
+// False is returned if no Node was passed yet.
+func (p *Path) Previous() (Node, bool) {
+	if p.index == 0 || p.index > len(p.nodes) {
+		return Node{}, false
+	}
+	return p.nodes[p.index-1], true
+}
+
+// Advance moves on to the next Node of the Path.
+func (p *Path) Advance() {
+	if !p.Finished() {
+		p.index++
+	}
+}
+
+// Finished checks if all Nodes of the Path were passed.
+func (p *Path) Finished() bool {
+	return p.index >= len(p.nodes)
+}
+
+// Target returns the last Node of the Path. False is returned if the Path has
+// no Nodes.
+func (p *Path) Target() (Node, bool) {
+	if len(p.nodes) == 0 {
+		return Node{}, false
+	}
+	return p.nodes[len(p.nodes)-1], true
+}
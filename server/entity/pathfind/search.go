@@ -0,0 +1,440 @@
+package pathfind
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Config holds the properties of the entity that a path is searched for,
+// which influence the nodes that it is able to move through.
+type Config struct {
+	// Width and Height are the width and height of the bounding box of the
+	// entity. If 0, a width of 0.6 and a height of 1.8 are used.
+	Width, Height float64
+	// StepHeight is the height that the entity is able to walk up without
+	// jumping. If 0, a StepHeight of 0.6 is used.
+	StepHeight float64
+	// JumpHeight is the height that the entity is able to jump up. If 0, a
+	// JumpHeight of 1.25 is used.
+	JumpHeight float64
+	// MaxFallDistance is the number of blocks that the entity is willing to
+	// drop down. If 0, a MaxFallDistance of 3 is used.
+	MaxFallDistance int
+	// CanSwim specifies if the entity is able to swim. Entities that cannot
+	// swim still move through water, but avoid it where possible.
+	CanSwim bool
+	// CanOpenDoors specifies if the entity is able to open closed wooden
+	// doors.
+	CanOpenDoors bool
+	// NodesPerTick is the maximum number of nodes evaluated every time
+	// Search.Tick is called. If 0, 200 nodes are evaluated per tick.
+	NodesPerTick int
+	// MaxNodes is the maximum number of nodes evaluated by a Search. Once
+	// this number is reached, a partial path to the node closest to the
+	// target is returned. If 0, at most 2000 nodes are evaluated.
+	MaxNodes int
+}
+
+// withDefaults returns the Config with all zero fields set to their default
+// values.
+func (conf Config) withDefaults() Config {
+	if conf.Width == 0 {
+		conf.Width = 0.6
+	}
+	if conf.Height == 0 {
+		conf.Height = 1.8
+	}
+	if conf.StepHeight == 0 {
+		conf.StepHeight = 0.6
+	}
+	if conf.JumpHeight == 0 {
+		conf.JumpHeight = 1.25
+	}
+	if conf.MaxFallDistance == 0 {
+		conf.MaxFallDistance = 3
+	}
+	if conf.NodesPerTick == 0 {
+		conf.NodesPerTick = 200
+	}
+	if conf.MaxNodes == 0 {
+		conf.MaxNodes = 2000
+	}
+	return conf
+}
+
+// Standable checks if an entity with the Config is able to stand or swim with
+// its feet at the position passed.
+func (conf Config) Standable(w *world.World, pos cube.Pos) bool {
+	_, ok := (&Search{conf: conf.withDefaults()}).evaluate(w, pos)
+	return ok
+}
+
+// NewSearch starts an A* search for a path from one block position to
+// another. The search is performed over multiple ticks by calling Search.Tick.
+func (conf Config) NewSearch(from, to cube.Pos) *Search {
+	return &Search{conf: conf.withDefaults(), from: from, to: to, nodes: map[cube.Pos]*searchNode{}}
+}
+
+// Search is an A* search for a Path over the blocks of a world. To limit the
+// time spent searching every tick, the search is spread over multiple calls
+// to Tick.
+type Search struct {
+	conf     Config
+	from, to cube.Pos
+
+	open     openSet
+	nodes    map[cube.Pos]*searchNode
+	closest  *searchNode
+	expanded int
+	path     *Path
+}
+
+// searchNode is a Node that was discovered by a Search.
+type searchNode struct {
+	Node
+	g, h   float64
+	parent *searchNode
+	closed bool
+	index  int
+}
+
+// Tick continues the Search, evaluating at most Config.NodesPerTick nodes. If
+// the Search finished, the resulting Path is returned with true. If the target
+// cannot be reached, the Path returned is partial and leads to the position
+// closest to the target that was found.
+func (s *Search) Tick(w *world.World) (*Path, bool) {
+	if s.path != nil {
+		return s.path, true
+	}
+	if s.closest == nil {
+		start, ok := s.evaluate(w, s.from)
+		if !ok {
+			// The entity might currently be in the air. Start searching from
+			// its position regardless.
+			start = Node{Pos: s.from, Floor: float64(s.from[1])}
+		}
+		s.closest = &searchNode{Node: start, h: s.heuristic(s.from)}
+		s.nodes[s.from] = s.closest
+		heap.Push(&s.open, s.closest)
+	}
+	for i := 0; i < s.conf.NodesPerTick; i++ {
+		if s.open.Len() == 0 || s.expanded >= s.conf.MaxNodes {
+			return s.finish(s.closest, true), true
+		}
+		n := heap.Pop(&s.open).(*searchNode)
+		n.closed = true
+		s.expanded++
+
+		if n.Pos == s.to {
+			return s.finish(n, false), true
+		}
+		if n.h < s.closest.h || (n.h == s.closest.h && n.g < s.closest.g) {
+			s.closest = n
+		}
+		for _, next := range s.neighbours(w, n.Node) {
+			s.visit(n, next)
+		}
+	}
+	return nil, false
+}
+
+// visit adds the Node passed to the open set of the Search, or updates it if
+// a cheaper way to reach it through the parent passed was found.
+func (s *Search) visit(parent *searchNode, next Node) {
+	g := parent.g + s.cost(parent.Node, next)
+	n, ok := s.nodes[next.Pos]
+	if !ok {
+		n = &searchNode{Node: next, g: g, h: s.heuristic(next.Pos), parent: parent}
+		s.nodes[next.Pos] = n
+		heap.Push(&s.open, n)
+		return
+	}
+	if n.closed || g >= n.g {
+		return
+	}
+	n.Node, n.g, n.parent = next, g, parent
+	heap.Fix(&s.open, n.index)
+}
+
+// finish builds the Path leading to the searchNode passed.
+func (s *Search) finish(n *searchNode, partial bool) *Path {
+	var nodes []Node
+	for ; n != nil; n = n.parent {
+		nodes = append(nodes, n.Node)
+	}
+	slices.Reverse(nodes)
+	s.path = &Path{nodes: nodes, partial: partial}
+	// The first node is the position that the entity started at, so it does
+	// not need to be moved towards.
+	s.path.Advance()
+	return s.path
+}
+
+// heuristic estimates the cost of moving from the position passed to the
+// target of the Search.
+func (s *Search) heuristic(pos cube.Pos) float64 {
+	dx, dy, dz := math.Abs(float64(pos[0]-s.to[0])), math.Abs(float64(pos[1]-s.to[1])), math.Abs(float64(pos[2]-s.to[2]))
+	return math.Max(dx, dz) + (math.Sqrt2-1)*math.Min(dx, dz) + dy
+}
+
+// cost returns the cost of moving from one Node to another.
+func (s *Search) cost(from, to Node) float64 {
+	dx, dz := from.Pos[0]-to.Pos[0], from.Pos[2]-to.Pos[2]
+	c := 1.0
+	if dx != 0 && dz != 0 {
+		c = math.Sqrt2
+	}
+	if dy := to.Floor - from.Floor; dy > s.conf.StepHeight {
+		// Jumping is slower than walking.
+		c += 0.5
+	} else if dy < 0 {
+		c += -dy * 0.5
+	}
+	switch to.Kind {
+	case NodeSwim:
+		if !s.conf.CanSwim {
+			c *= 8
+		} else {
+			c *= 2
+		}
+	case NodeDoor:
+		c += 1
+	}
+	return c
+}
+
+// neighbours returns all Nodes that an entity is able to move to from the
+// Node passed.
+func (s *Search) neighbours(w *world.World, n Node) []Node {
+	neighbours := make([]Node, 0, 10)
+	for _, d := range [...][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}, {1, 1}, {1, -1}, {-1, 1}, {-1, -1}} {
+		if d[0] != 0 && d[1] != 0 {
+			// Diagonal movement is only possible if the entity does not cut
+			// any corners.
+			if !s.clear(w, n.Pos.Add(cube.Pos{d[0], 0, 0})) || !s.clear(w, n.Pos.Add(cube.Pos{0, 0, d[1]})) {
+				continue
+			}
+		}
+		if next, ok := s.horizontal(w, n, n.Pos.Add(cube.Pos{d[0], 0, d[1]})); ok {
+			neighbours = append(neighbours, next)
+		}
+	}
+	if n.Kind == NodeSwim {
+		for _, pos := range [...]cube.Pos{n.Pos.Side(cube.FaceUp), n.Pos.Side(cube.FaceDown)} {
+			if next, ok := s.evaluate(w, pos); ok {
+				neighbours = append(neighbours, next)
+			}
+		}
+	}
+	return neighbours
+}
+
+// horizontal finds the Node that an entity ends up at when moving from the
+// Node passed towards the horizontally adjacent position passed. The entity
+// may walk, jump up or fall down to reach the Node.
+func (s *Search) horizontal(w *world.World, from Node, pos cube.Pos) (Node, bool) {
+	if next, ok := s.evaluate(w, pos); ok {
+		if next.Floor-from.Floor <= s.conf.StepHeight {
+			return next, true
+		}
+		if next.Floor-from.Floor <= s.conf.JumpHeight && s.headroom(w, from) {
+			return next, true
+		}
+		return Node{}, false
+	}
+	if next, ok := s.evaluate(w, pos.Side(cube.FaceUp)); ok {
+		if next.Floor-from.Floor <= s.conf.JumpHeight && s.headroom(w, from) {
+			return next, true
+		}
+		return Node{}, false
+	}
+	if !s.clear(w, pos) {
+		return Node{}, false
+	}
+	for dy := 1; dy <= s.conf.MaxFallDistance; dy++ {
+		below := pos.Sub(cube.Pos{0, dy})
+		if next, ok := s.evaluate(w, below); ok {
+			return next, true
+		}
+		if !s.clear(w, below) {
+			break
+		}
+	}
+	return Node{}, false
+}
+
+// evaluate checks if an entity is able to stand or swim with its feet at the
+// position passed. If so, the Node at the position is returned.
+func (s *Search) evaluate(w *world.World, pos cube.Pos) (Node, bool) {
+	kind, feet := NodeWalk, 0.0
+	for _, col := range s.footprint(pos) {
+		for y := 0; y < s.blockHeight(); y++ {
+			p := col.Add(cube.Pos{0, y})
+			b := w.Block(p)
+			if dangerous(w, p, b) {
+				return Node{}, false
+			}
+			if y == 0 {
+				if l, ok := w.Liquid(p); ok {
+					if _, water := l.(block.Water); water {
+						kind = NodeSwim
+					}
+				}
+			}
+			switch b := b.(type) {
+			case block.WoodDoor:
+				if b.Open {
+					continue
+				}
+				if !s.conf.CanOpenDoors {
+					return Node{}, false
+				}
+				kind = NodeDoor
+				continue
+			case block.WoodFenceGate:
+				if !b.Open {
+					return Node{}, false
+				}
+				continue
+			}
+			h := height(w, p, b)
+			if h == 0 {
+				continue
+			}
+			if y == 0 && h <= s.conf.StepHeight {
+				// Blocks like carpet, slabs and snow layers may be stood on
+				// without leaving the block.
+				feet = math.Max(feet, h)
+				continue
+			}
+			return Node{}, false
+		}
+	}
+	if feet > 0 {
+		return Node{Pos: pos, Floor: float64(pos[1]) + feet, Kind: kind}, true
+	}
+	floor := math.Inf(-1)
+	for _, col := range s.footprint(pos) {
+		below := col.Side(cube.FaceDown)
+		b := w.Block(below)
+		if dangerous(w, below, b) {
+			return Node{}, false
+		}
+		if h := height(w, below, b); h > 0 {
+			floor = math.Max(floor, float64(below[1])+h)
+		}
+	}
+	if !math.IsInf(floor, -1) {
+		if kind == NodeSwim {
+			// The entity is able to walk on the bottom of shallow water.
+			kind = NodeWalk
+		}
+		return Node{Pos: pos, Floor: floor, Kind: kind}, true
+	}
+	if kind == NodeSwim {
+		return Node{Pos: pos, Floor: float64(pos[1]), Kind: kind}, true
+	}
+	return Node{}, false
+}
+
+// clear checks if all blocks occupied by an entity with its feet at the
+// position passed can be moved through, regardless of the floor below.
+func (s *Search) clear(w *world.World, pos cube.Pos) bool {
+	for _, col := range s.footprint(pos) {
+		for y := 0; y < s.blockHeight(); y++ {
+			p := col.Add(cube.Pos{0, y})
+			b := w.Block(p)
+			if dangerous(w, p, b) || height(w, p, b) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// headroom checks if the entity has room to jump up at the Node passed.
+func (s *Search) headroom(w *world.World, n Node) bool {
+	for _, col := range s.footprint(n.Pos) {
+		p := col.Add(cube.Pos{0, int(math.Ceil(n.Floor - float64(n.Pos[1]) + s.conf.Height))})
+		if height(w, p, w.Block(p)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// footprint returns the positions of all block columns that an entity with
+// its feet at the position passed occupies.
+func (s *Search) footprint(pos cube.Pos) []cube.Pos {
+	size := int(math.Ceil(s.conf.Width))
+	if size <= 1 {
+		return []cube.Pos{pos}
+	}
+	positions := make([]cube.Pos, 0, size*size)
+	for x := 0; x < size; x++ {
+		for z := 0; z < size; z++ {
+			positions = append(positions, pos.Add(cube.Pos{x, 0, z}))
+		}
+	}
+	return positions
+}
+
+// blockHeight returns the number of blocks that the entity occupies
+// vertically.
+func (s *Search) blockHeight() int {
+	return int(math.Ceil(s.conf.Height))
+}
+
+// height returns the height of the highest collision box of the block passed.
+// If the block has no collision boxes, 0 is returned.
+func height(w *world.World, pos cube.Pos, b world.Block) float64 {
+	h := 0.0
+	for _, box := range b.Model().BBox(pos, w) {
+		h = math.Max(h, box.Max()[1])
+	}
+	return h
+}
+
+// dangerous checks if the block at the position passed damages entities that
+// move into it, such as fire or lava.
+func dangerous(w *world.World, pos cube.Pos, b world.Block) bool {
+	switch b.(type) {
+	case block.Fire, block.Lava, block.Cactus:
+		return true
+	}
+	if l, ok := w.Liquid(pos); ok {
+		if _, lava := l.(block.Lava); lava {
+			return true
+		}
+	}
+	return false
+}
+
+// openSet is a priority queue of searchNodes ordered by their estimated total
+// cost.
+type openSet []*searchNode
+
+func (o openSet) Len() int { return len(o) }
+func (o openSet) Less(i, j int) bool {
+	return o[i].g+o[i].h < o[j].g+o[j].h
+}
+func (o openSet) Swap(i, j int) {
+	o[i], o[j] = o[j], o[i]
+	o[i].index, o[j].index = i, j
+}
+func (o *openSet) Push(x any) {
+	n := x.(*searchNode)
+	n.index = len(*o)
+	*o = append(*o, n)
+}
+func (o *openSet) Pop() any {
+	old := *o
+	n := old[len(old)-1]
+	*o = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewPig creates a new adult pig at the position passed. Pigs drop porkchops
+// when killed.
+func NewPig(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:   world.MobCategoryCreature,
+		MaxHealth:  10,
+		Speed:      0.1,
+		Experience: animalExperience,
+		Drops:      pigDrops,
+		Behaviour: AnimalBehaviourConfig{
+			Food:      pigFood,
+			Offspring: func(parent, _ *Mob) *Mob { return NewPig(parent.Position()) },
+		}.New(),
+	}.New(PigType{}, pos)
+}
+
+// pigFood checks if the item stack passed is food that pigs eat: carrots,
+// potatoes or beetroots.
+func pigFood(s item.Stack) bool {
+	switch s.Item().(type) {
+	case block.Carrot, block.Potato, item.Beetroot:
+		return true
+	}
+	return false
+}
+
+// pigDrops returns the items dropped by a pig when it dies.
+func pigDrops(m *Mob, src world.DamageSource) []item.Stack {
+	if a, ok := animalOf(m); ok && a.Baby() {
+		return nil
+	}
+	return []item.Stack{item.NewStack(item.Porkchop{Cooked: m.OnFireDuration() > 0}, rand.Intn(3)+1+lootingBonus(src))}
+}
+
+// PigType is a world.EntityType implementation for pigs.
+type PigType struct{}
+
+func (PigType) EncodeEntity() string { return "minecraft:pig" }
+func (PigType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.9, 0.9)
+}
+
+func (PigType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeAnimal(NewPig, data)
+}
+
+func (PigType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeAnimal(e.(*Mob))
+}
@@ -172,6 +172,9 @@ func (lt *ProjectileBehaviour) Tick(e *Ent) *Movement {
 		if t, ok := w.Block(bpos).(block.TNT); ok && e.OnFireDuration() > 0 {
 			t.Ignite(bpos, w, e)
 		}
+		if h, ok := w.Block(bpos).(block.ProjectileHitter); ok {
+			h.ProjectileHit(bpos, r.Face(), r.Position(), w, e)
+		}
 		if lt.conf.SurviveBlockCollision {
 			lt.hitBlockSurviving(e, r, m)
 			return m
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/enchantment"
@@ -15,21 +16,51 @@ import (
 var DefaultRegistry = conf.New([]world.EntityType{
 	AreaEffectCloudType{},
 	ArrowType{},
+	BoatType{},
 	BottleOfEnchantingType{},
+	ChestBoatType{},
+	ChestMinecartType{},
+	CatType{},
+	ChickenType{},
+	CodType{},
+	CowType{},
+	CreeperType{},
 	EggType{},
 	EnderPearlType{},
 	ExperienceOrbType{},
 	FallingBlockType{},
 	FireworkType{},
+	FishingHookType{},
+	HopperMinecartType{},
 	ItemType{},
 	LightningType{},
 	LingeringPotionType{},
+	MinecartType{},
+	NPCType{},
+	PigType{},
+	PufferfishType{},
+	SalmonType{},
+	SheepType{},
+	SkeletonType{},
 	SnowballType{},
+	SpiderType{},
 	SplashPotionType{},
+	SquidType{},
+	TNTMinecartType{},
 	TNTType{},
 	TextType{},
+	TropicalFishType{},
+	VillagerType{},
+	WolfType{},
+	ZombieType{},
 })
 
+func init() {
+	for _, t := range []item.BucketableEntityType{CodType{}, SalmonType{}, TropicalFishType{}, PufferfishType{}} {
+		world.RegisterItem(item.Bucket{Content: item.EntityBucketContent(block.Water{Still: true, Depth: 8}, t)})
+	}
+}
+
 var conf = world.EntityRegistryConfig{
 	Item: func(it any, pos, vel mgl64.Vec3) world.Entity {
 		i := NewItem(it.(item.Stack), pos)
@@ -72,6 +103,11 @@ var conf = world.EntityRegistryConfig{
 	Firework: func(pos mgl64.Vec3, rot cube.Rotation, attached bool, firework world.Item, owner world.Entity) world.Entity {
 		return NewFireworkAttached(pos, rot, firework.(item.Firework), owner, attached)
 	},
+	FishingHook: func(pos, vel mgl64.Vec3, owner world.Entity, lure, luckOfTheSea int) world.Entity {
+		h := NewFishingHook(pos, owner, lure, luckOfTheSea)
+		h.vel = vel
+		return h
+	},
 	LingeringPotion: func(pos, vel mgl64.Vec3, t any, owner world.Entity) world.Entity {
 		p := NewLingeringPotion(pos, owner, t.(potion.Potion))
 		p.vel = vel
@@ -90,4 +126,22 @@ var conf = world.EntityRegistryConfig{
 	Lightning: func(pos mgl64.Vec3) world.Entity {
 		return NewLightning(pos)
 	},
+	Minecart: func(pos mgl64.Vec3) world.Entity {
+		return NewMinecart(pos)
+	},
+	ChestMinecart: func(pos mgl64.Vec3) world.Entity {
+		return NewChestMinecart(pos)
+	},
+	HopperMinecart: func(pos mgl64.Vec3) world.Entity {
+		return NewHopperMinecart(pos)
+	},
+	TNTMinecart: func(pos mgl64.Vec3) world.Entity {
+		return NewTNTMinecart(pos)
+	},
+	Boat: func(pos mgl64.Vec3, yaw float64, wood any, chest bool) world.Entity {
+		if chest {
+			return NewChestBoat(pos, yaw, wood.(block.WoodType))
+		}
+		return NewBoat(pos, yaw, wood.(block.WoodType))
+	},
 }
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Rider represents an entity that is able to ride another entity, such as a
+// player riding a minecart.
+type Rider interface {
+	world.Entity
+	// RideEntity makes the Rider start riding the Rideable entity passed. If
+	// the Rider is already riding another entity, it is dismounted first.
+	RideEntity(e Rideable)
+	// DismountEntity makes the Rider stop riding the entity that it is
+	// currently riding. DismountEntity does nothing if the Rider is not riding
+	// any entity.
+	DismountEntity()
+	// RidingEntity returns the entity that the Rider is currently riding. If
+	// the Rider is not riding any entity, false is returned.
+	RidingEntity() (Rideable, bool)
+}
+
+// Rideable represents an entity that may be ridden by one or more Riders,
+// such as a minecart or a boat.
+type Rideable interface {
+	world.Entity
+	// AddPassenger seats the Rider passed on a free seat of the entity. False
+	// is returned if all seats of the entity are taken or if the entity cannot
+	// be ridden at all. AddPassenger is called by Rider.RideEntity and should
+	// generally not be called directly.
+	AddPassenger(r Rider) bool
+	// RemovePassenger removes the Rider passed from the seat it is on. It is
+	// called by Rider.DismountEntity and should generally not be called
+	// directly.
+	RemovePassenger(r Rider)
+	// Passengers returns all Riders currently riding the entity, ordered by
+	// the seat that they are on. The first passenger is the driver of the
+	// entity.
+	Passengers() []Rider
+	// SeatOffset returns the offset from the position of the entity at which
+	// the Rider passed is seated.
+	SeatOffset(r Rider) mgl64.Vec3
+}
+
+// RiderInput holds the movement input of a Rider. It is passed to the entity
+// that the Rider is driving so that it may be steered.
+type RiderInput struct {
+	// Forward is the forward movement input of the Rider, ranging from -1
+	// (backwards) to 1 (forwards).
+	Forward float64
+	// Sideways is the sideways movement input of the Rider, ranging from -1
+	// (right) to 1 (left).
+	Sideways float64
+	// Rotation is the rotation of the Rider.
+	Rotation cube.Rotation
+	// Jumping is true if the Rider is holding the jump button.
+	Jumping bool
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"sync"
+
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Seats keeps track of the Riders riding a Rideable entity. Behaviours that
+// may be ridden embed a *Seats and expose it through a Seats method, after
+// which the Ent using the Behaviour implements Rideable.
+type Seats struct {
+	offsets []mgl64.Vec3
+
+	mu         sync.Mutex
+	passengers []Rider
+}
+
+// NewSeats creates Seats with one seat for every offset passed. The offsets
+// are relative to the position of the entity ridden. The first seat is that
+// of the driver.
+func NewSeats(offsets ...mgl64.Vec3) *Seats {
+	return &Seats{offsets: offsets, passengers: make([]Rider, len(offsets))}
+}
+
+// Seats returns itself, so that Behaviours embedding a *Seats satisfy the
+// interface used by Ent to find them.
+func (s *Seats) Seats() *Seats {
+	return s
+}
+
+// Passengers returns all Riders currently seated, ordered by their seat.
+func (s *Seats) Passengers() []Rider {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	passengers := make([]Rider, 0, len(s.passengers))
+	for _, r := range s.passengers {
+		if r != nil {
+			passengers = append(passengers, r)
+		}
+	}
+	return passengers
+}
+
+// Driver returns the Rider on the first seat, if any.
+func (s *Seats) Driver() (Rider, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.passengers) == 0 || s.passengers[0] == nil {
+		return nil, false
+	}
+	return s.passengers[0], true
+}
+
+// Full checks if all seats are taken.
+func (s *Seats) Full() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.passengers {
+		if r == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Offset returns the offset of the seat that the Rider passed is on. If the
+// Rider is not seated, the offset of the first seat is returned.
+func (s *Seats) Offset(r Rider) mgl64.Vec3 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.offsets) == 0 {
+		return mgl64.Vec3{}
+	}
+	for i, p := range s.passengers {
+		if p == r {
+			return s.offsets[i]
+		}
+	}
+	return s.offsets[0]
+}
+
+// DismountAll dismounts all Riders that are currently seated.
+func (s *Seats) DismountAll() {
+	for _, r := range s.Passengers() {
+		r.DismountEntity()
+	}
+	// Riders may refuse to be dismounted, but they are removed from their
+	// seats regardless.
+	s.mu.Lock()
+	clear(s.passengers)
+	s.mu.Unlock()
+}
+
+// add seats the Rider passed on the first free seat. False is returned if
+// there is no free seat.
+func (s *Seats) add(r Rider) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, p := range s.passengers {
+		if p == r {
+			return true
+		}
+		if p == nil {
+			s.passengers[i] = r
+			return true
+		}
+	}
+	return false
+}
+
+// remove removes the Rider passed from its seat.
+func (s *Seats) remove(r Rider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, p := range s.passengers {
+		if p == r {
+			s.passengers[i] = nil
+		}
+	}
+}
+
+// validate removes all Riders from their seats that are no longer riding the
+// entity passed.
+func (s *Seats) validate(e Rideable) {
+	for _, r := range s.Passengers() {
+		if v, ok := r.RidingEntity(); !ok || v != e {
+			s.remove(r)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package entity
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewSheep creates a new adult sheep at the position passed with a random
+// natural wool colour. Sheep may be sheared using shears and regrow their wool
+// by eating grass.
+func NewSheep(pos mgl64.Vec3) *Mob {
+	return NewSheepWithColour(pos, randomSheepColour())
+}
+
+// NewSheepWithColour creates a new adult sheep at the position passed with
+// wool of the colour passed.
+func NewSheepWithColour(pos mgl64.Vec3, colour item.Colour) *Mob {
+	s := &sheepBehaviour{colour: colour}
+	s.AnimalBehaviour = AnimalBehaviourConfig{
+		Food:      wheatFood,
+		Offspring: sheepOffspring,
+		Goals: func(_ *Mob, b *ai.Brain) {
+			b.AddGoal(5, &eatGrassGoal{s: s})
+		},
+	}.New()
+	return MobConfig{
+		Category:   world.MobCategoryCreature,
+		MaxHealth:  8,
+		Speed:      0.1,
+		Experience: animalExperience,
+		Drops:      sheepDrops,
+		Behaviour:  s,
+	}.New(SheepType{}, pos)
+}
+
+// sheepBehaviour is the MobBehaviour of sheep.
+type sheepBehaviour struct {
+	*AnimalBehaviour
+
+	woolMu  sync.Mutex
+	colour  item.Colour
+	sheared bool
+}
+
+// Colour returns the colour of the wool of the sheep.
+func (s *sheepBehaviour) Colour() item.Colour {
+	s.woolMu.Lock()
+	defer s.woolMu.Unlock()
+	return s.colour
+}
+
+// Sheared checks if the sheep was sheared and has no wool.
+func (s *sheepBehaviour) Sheared() bool {
+	s.woolMu.Lock()
+	defer s.woolMu.Unlock()
+	return s.sheared
+}
+
+// Interact shears the sheep if the user is holding shears, or dyes its wool
+// if the user is holding dye. Otherwise, the sheep is fed the item held.
+func (s *sheepBehaviour) Interact(m *Mob, user item.User) bool {
+	held, left := user.HeldItems()
+	switch it := held.Item().(type) {
+	case item.Shears:
+		if s.Baby() || !s.shear(m) {
+			return false
+		}
+		if !creative(user) {
+			user.SetHeldItems(held.Damage(1), left)
+		}
+		return true
+	case item.Dye:
+		s.woolMu.Lock()
+		dyed := s.colour != it.Colour && !s.sheared
+		if dyed {
+			s.colour = it.Colour
+		}
+		s.woolMu.Unlock()
+		if !dyed {
+			return false
+		}
+		m.updateState()
+		if !creative(user) {
+			user.SetHeldItems(held.Grow(-1), left)
+		}
+		return true
+	}
+	return s.AnimalBehaviour.Interact(m, user)
+}
+
+// shear removes the wool of the sheep, dropping 1-3 wool blocks. False is
+// returned if the sheep was already sheared.
+func (s *sheepBehaviour) shear(m *Mob) bool {
+	s.woolMu.Lock()
+	if s.sheared {
+		s.woolMu.Unlock()
+		return false
+	}
+	s.sheared = true
+	colour := s.colour
+	s.woolMu.Unlock()
+	m.updateState()
+
+	w, pos := m.World(), m.Position()
+	w.PlaySound(pos, sound.Shear{})
+	for i := rand.Intn(3) + 1; i > 0; i-- {
+		it := NewItem(item.NewStack(block.Wool{Colour: colour}, 1), pos.Add(mgl64.Vec3{0, 1}))
+		it.SetVelocity(mgl64.Vec3{(rand.Float64() - rand.Float64()) * 0.1, rand.Float64() * 0.05, (rand.Float64() - rand.Float64()) * 0.1})
+		w.AddEntity(it)
+	}
+	return true
+}
+
+// sheepOffspring returns a baby sheep with the wool colour of one of its
+// parents.
+func sheepOffspring(parent, partner *Mob) *Mob {
+	colour := parent.Behaviour().(*sheepBehaviour).Colour()
+	if rand.Intn(2) == 0 {
+		colour = partner.Behaviour().(*sheepBehaviour).Colour()
+	}
+	return NewSheepWithColour(parent.Position(), colour)
+}
+
+// randomSheepColour returns a random wool colour for a naturally spawned
+// sheep, following the same distribution as vanilla.
+func randomSheepColour() item.Colour {
+	switch n := rand.Float64() * 100; {
+	case n < 5:
+		return item.ColourBlack()
+	case n < 10:
+		return item.ColourGrey()
+	case n < 15:
+		return item.ColourLightGrey()
+	case n < 18:
+		return item.ColourBrown()
+	case n < 18.164:
+		return item.ColourPink()
+	}
+	return item.ColourWhite()
+}
+
+// sheepDrops returns the items dropped by a sheep when it dies.
+func sheepDrops(m *Mob, src world.DamageSource) []item.Stack {
+	s := m.Behaviour().(*sheepBehaviour)
+	if s.Baby() {
+		return nil
+	}
+	drops := []item.Stack{item.NewStack(item.Mutton{Cooked: m.OnFireDuration() > 0}, rand.Intn(2)+1+lootingBonus(src))}
+	if !s.Sheared() {
+		drops = append(drops, item.NewStack(block.Wool{Colour: s.Colour()}, 1))
+	}
+	return drops
+}
+
+// eatGrassGoal is an ai.Goal that makes a sheep eat grass from time to time,
+// regrowing its wool.
+type eatGrassGoal struct {
+	s     *sheepBehaviour
+	ticks int
+}
+
+// Flags ...
+func (g *eatGrassGoal) Flags() ai.Flag {
+	return ai.FlagMove | ai.FlagLook | ai.FlagJump
+}
+
+// CanStart ...
+func (g *eatGrassGoal) CanStart(b *ai.Brain) bool {
+	chance := 1000
+	if g.s.Baby() {
+		chance = 50
+	}
+	if rand.Intn(chance) != 0 {
+		return false
+	}
+	_, ok := g.grass(b.Mob().(*Mob))
+	return ok
+}
+
+// CanContinue ...
+func (g *eatGrassGoal) CanContinue(*ai.Brain) bool {
+	return g.ticks > 0
+}
+
+// Start ...
+func (g *eatGrassGoal) Start(b *ai.Brain) {
+	g.ticks = 40
+	b.Navigator().Stop()
+	m := b.Mob().(*Mob)
+	for _, v := range m.viewers() {
+		v.ViewEntityAction(m, EatGrassAction{})
+	}
+}
+
+// Stop ...
+func (g *eatGrassGoal) Stop(*ai.Brain) {
+	g.ticks = 0
+}
+
+// Tick ...
+func (g *eatGrassGoal) Tick(b *ai.Brain) {
+	if g.ticks--; g.ticks != 4 {
+		return
+	}
+	m := b.Mob().(*Mob)
+	pos, ok := g.grass(m)
+	if !ok {
+		return
+	}
+	w := m.World()
+	if gr, ok := w.Block(pos).(block.TallGrass); ok {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: gr})
+	} else {
+		w.SetBlock(pos, block.Dirt{}, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: block.Grass{}})
+	}
+	g.s.woolMu.Lock()
+	g.s.sheared = false
+	g.s.woolMu.Unlock()
+	g.s.grow(m, 1200)
+	m.updateState()
+}
+
+// grass returns the position of the grass that the sheep passed is able to
+// eat: tall grass at its feet or a grass block below it.
+func (g *eatGrassGoal) grass(m *Mob) (cube.Pos, bool) {
+	w, pos := m.World(), cube.PosFromVec3(m.Position())
+	if _, ok := w.Block(pos).(block.TallGrass); ok {
+		return pos, true
+	}
+	below := pos.Side(cube.FaceDown)
+	if _, ok := w.Block(below).(block.Grass); ok {
+		return below, true
+	}
+	return cube.Pos{}, false
+}
+
+// SheepType is a world.EntityType implementation for sheep.
+type SheepType struct{}
+
+func (SheepType) EncodeEntity() string { return "minecraft:sheep" }
+func (SheepType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.9, 1.3)
+}
+
+func (SheepType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeAnimal(NewSheep, data)
+	s := m.Behaviour().(*sheepBehaviour)
+	s.woolMu.Lock()
+	defer s.woolMu.Unlock()
+	s.colour = item.Colours()[nbtconv.Uint8(data, "Color")%16]
+	s.sheared = nbtconv.Bool(data, "Sheared")
+	return m
+}
+
+func (SheepType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	s := m.Behaviour().(*sheepBehaviour)
+	data := encodeAnimal(m)
+	data["Color"] = s.Colour().Uint8()
+	data["Sheared"] = s.Sheared()
+	return data
+}
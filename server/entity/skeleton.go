@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewSkeleton creates a new skeleton holding a bow at the position passed.
+// Skeletons shoot arrows at players from a distance and burn in daylight.
+func NewSkeleton(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		Category:   world.MobCategoryMonster,
+		MaxHealth:  20,
+		Speed:      0.125,
+		Undead:     true,
+		Experience: monsterExperience,
+		Drops:      skeletonDrops,
+		Behaviour: MonsterBehaviourConfig{
+			BurnsInDaylight: true,
+			Goals: func(_ *Mob, b *ai.Brain) {
+				b.AddGoal(3, &bowAttackGoal{})
+			},
+		}.New(),
+	}.New(SkeletonType{}, pos)
+	m.mainHand = item.NewStack(item.Bow{}, 1)
+	return m
+}
+
+// skeletonDrops returns the items dropped by a skeleton when it dies.
+func skeletonDrops(_ *Mob, src world.DamageSource) []item.Stack {
+	return []item.Stack{
+		item.NewStack(item.Bone{}, rand.Intn(3)+lootingBonus(src)),
+		item.NewStack(item.Arrow{}, rand.Intn(3)+lootingBonus(src)),
+	}
+}
+
+// bowAttackGoal is an ai.Goal that makes a mob holding a bow shoot arrows at
+// its target. The mob moves towards its target until it is within range and
+// able to see it.
+type bowAttackGoal struct {
+	cooldown, seen int
+}
+
+// Flags ...
+func (g *bowAttackGoal) Flags() ai.Flag {
+	return ai.FlagMove | ai.FlagLook | ai.FlagAttack
+}
+
+// CanStart ...
+func (g *bowAttackGoal) CanStart(b *ai.Brain) bool {
+	_, ok := b.Target()
+	return ok && g.holdingBow(b.Mob().(*Mob))
+}
+
+// CanContinue ...
+func (g *bowAttackGoal) CanContinue(b *ai.Brain) bool {
+	return g.CanStart(b)
+}
+
+// Start ...
+func (g *bowAttackGoal) Start(*ai.Brain) {
+	g.cooldown, g.seen = 20, 0
+}
+
+// Stop ...
+func (g *bowAttackGoal) Stop(b *ai.Brain) {
+	b.Navigator().Stop()
+}
+
+// Tick ...
+func (g *bowAttackGoal) Tick(b *ai.Brain) {
+	target, ok := b.Target()
+	if !ok {
+		return
+	}
+	m := b.Mob().(*Mob)
+	m.LookAt(EyePosition(target))
+
+	if m.CanSee(target) {
+		g.seen++
+	} else {
+		g.seen = 0
+	}
+	if dist := target.Position().Sub(m.Position()).Len(); dist <= 15 && g.seen >= 20 {
+		b.Navigator().Stop()
+	} else {
+		b.Navigator().NavigateTo(cube.PosFromVec3(target.Position()), 1)
+	}
+	if g.cooldown--; g.cooldown > 0 || g.seen == 0 {
+		return
+	}
+	g.shoot(m, target)
+	g.cooldown = 40
+	if m.World().Difficulty() == world.DifficultyHard {
+		g.cooldown = 20
+	}
+}
+
+// shoot makes the Mob passed shoot an arrow at the target passed.
+func (g *bowAttackGoal) shoot(m *Mob, target world.Entity) {
+	w := m.World()
+	start := EyePosition(m).Sub(mgl64.Vec3{0, 0.1})
+	diff := target.Position().Add(mgl64.Vec3{0, target.Type().BBox(target).Height() / 3}).Sub(start)
+	// Aim a little higher the further away the target is, to make up for the
+	// gravity of the arrow.
+	diff[1] += math.Hypot(diff[0], diff[2]) * 0.2
+	if diff.Len() == 0 {
+		return
+	}
+	diff = diff.Normalize()
+
+	// Arrows are less accurate on lower difficulties.
+	id, _ := world.DifficultyID(w.Difficulty())
+	inaccuracy := float64(14-id*4) * 0.0075
+	vel := diff.Add(mgl64.Vec3{rand.NormFloat64() * inaccuracy, rand.NormFloat64() * inaccuracy, rand.NormFloat64() * inaccuracy}).Mul(1.6)
+
+	dmg := 2 + rand.NormFloat64()*0.25 + float64(id)*0.11
+	rot := cube.Rotation{
+		mgl64.RadToDeg(math.Atan2(vel[0], vel[2])),
+		mgl64.RadToDeg(math.Atan2(vel[1], math.Hypot(vel[0], vel[2]))),
+	}
+	// Arrows shot by skeletons cannot be picked up by players.
+	arrow := w.EntityRegistry().Config().Arrow(start, vel, rot, dmg, m, false, true, false, 0, potion.Potion{})
+
+	w.PlaySound(m.Position(), sound.BowShoot{})
+	w.AddEntity(arrow)
+}
+
+// holdingBow checks if the Mob passed is holding a bow.
+func (g *bowAttackGoal) holdingBow(m *Mob) bool {
+	mainHand, _ := m.HeldItems()
+	_, ok := mainHand.Item().(item.Bow)
+	return ok
+}
+
+// SkeletonType is a world.EntityType implementation for skeletons.
+type SkeletonType struct{}
+
+func (SkeletonType) EncodeEntity() string { return "minecraft:skeleton" }
+func (SkeletonType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.99, 0.3)
+}
+
+func (SkeletonType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMob(NewSkeleton(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (SkeletonType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMob(e.(*Mob))
+}
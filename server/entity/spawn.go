@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// BiomeSpawns returns the world.SpawnEntries of the mobs that spawn naturally
+// in the world.Biome passed. BiomeSpawns may be used as the Spawns field of a
+// world.Config.
+func BiomeSpawns(b world.Biome) []world.SpawnEntry {
+	switch b.(type) {
+	case biome.NetherWastes, biome.CrimsonForest, biome.WarpedForest, biome.SoulSandValley, biome.BasaltDeltas,
+		biome.End, biome.MushroomFields, biome.MushroomFieldShore, biome.DeepDark:
+		return nil
+	case biome.Ocean, biome.DeepOcean:
+		return oceanSpawns
+	case biome.ColdOcean, biome.DeepColdOcean, biome.FrozenOcean, biome.DeepFrozenOcean, biome.LegacyFrozenOcean:
+		return coldOceanSpawns
+	case biome.LukewarmOcean, biome.DeepLukewarmOcean, biome.WarmOcean, biome.DeepWarmOcean:
+		return warmOceanSpawns
+	case biome.River, biome.FrozenRiver:
+		return riverSpawns
+	case biome.Desert, biome.DesertHills, biome.DesertLakes, biome.Badlands, biome.BadlandsPlateau,
+		biome.ErodedBadlands, biome.ModifiedBadlandsPlateau, biome.WoodedBadlandsPlateau,
+		biome.ModifiedWoodedBadlandsPlateau, biome.Beach, biome.SnowyBeach, biome.StonyShore, biome.FrozenPeaks,
+		biome.JaggedPeaks, biome.StonyPeaks, biome.SnowySlopes, biome.IceSpikes, biome.DripstoneCaves, biome.LushCaves:
+		return monsterSpawns
+	}
+	return overworldSpawns
+}
+
+var (
+	// monsterSpawns holds the monsters that spawn in most biomes of the
+	// overworld.
+	monsterSpawns = []world.SpawnEntry{
+		{Category: world.MobCategoryMonster, Weight: 100, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewSpider)},
+		{Category: world.MobCategoryMonster, Weight: 95, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewZombie)},
+		{Category: world.MobCategoryMonster, Weight: 100, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewSkeleton)},
+		{Category: world.MobCategoryMonster, Weight: 100, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewCreeper)},
+	}
+	// creatureSpawns holds the animals that spawn on grass in most biomes of
+	// the overworld.
+	creatureSpawns = []world.SpawnEntry{
+		{Category: world.MobCategoryCreature, Weight: 12, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewSheep), Condition: onGrass},
+		{Category: world.MobCategoryCreature, Weight: 10, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewPig), Condition: onGrass},
+		{Category: world.MobCategoryCreature, Weight: 10, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewChicken), Condition: onGrass},
+		{Category: world.MobCategoryCreature, Weight: 8, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewCow), Condition: onGrass},
+	}
+	overworldSpawns = slices.Concat(creatureSpawns, monsterSpawns)
+
+	oceanSpawns = slices.Concat(monsterSpawns, []world.SpawnEntry{
+		{Category: world.MobCategoryWater, Weight: 1, MinGroup: 1, MaxGroup: 4, New: spawnMob(NewSquid)},
+		{Category: world.MobCategoryWater, Weight: 10, MinGroup: 3, MaxGroup: 6, New: spawnMob(NewCod)},
+	})
+	coldOceanSpawns = slices.Concat(monsterSpawns, []world.SpawnEntry{
+		{Category: world.MobCategoryWater, Weight: 3, MinGroup: 1, MaxGroup: 4, New: spawnMob(NewSquid)},
+		{Category: world.MobCategoryWater, Weight: 15, MinGroup: 1, MaxGroup: 5, New: spawnMob(NewSalmon)},
+	})
+	warmOceanSpawns = slices.Concat(monsterSpawns, []world.SpawnEntry{
+		{Category: world.MobCategoryWater, Weight: 10, MinGroup: 1, MaxGroup: 2, New: spawnMob(NewSquid)},
+		{Category: world.MobCategoryWater, Weight: 25, MinGroup: 8, MaxGroup: 8, New: spawnMob(NewTropicalFish)},
+		{Category: world.MobCategoryWater, Weight: 15, MinGroup: 1, MaxGroup: 3, New: spawnMob(NewPufferfish)},
+	})
+	riverSpawns = slices.Concat(monsterSpawns, []world.SpawnEntry{
+		{Category: world.MobCategoryWater, Weight: 2, MinGroup: 1, MaxGroup: 4, New: spawnMob(NewSquid)},
+		{Category: world.MobCategoryWater, Weight: 5, MinGroup: 1, MaxGroup: 5, New: spawnMob(NewSalmon)},
+	})
+)
+
+// spawnMob converts a function creating a Mob into a function that may be used
+// as the New field of a world.SpawnEntry.
+func spawnMob(f func(pos mgl64.Vec3) *Mob) func(pos mgl64.Vec3) world.Entity {
+	return func(pos mgl64.Vec3) world.Entity {
+		return f(pos)
+	}
+}
+
+// onGrass checks if the block below the position passed is grass. It is used
+// as the spawn condition of animals.
+func onGrass(w *world.World, pos cube.Pos) bool {
+	_, ok := w.Block(pos.Side(cube.FaceDown)).(block.Grass)
+	return ok
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewSpider creates a new spider at the position passed. Spiders climb walls
+// and only attack players on their own accord in the dark.
+func NewSpider(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:     world.MobCategoryMonster,
+		MaxHealth:    16,
+		Speed:        0.15,
+		EyeHeight:    0.65,
+		AttackDamage: 2,
+		Arthropod:    true,
+		Experience:   monsterExperience,
+		Drops:        spiderDrops,
+		Behaviour: spiderBehaviour{MonsterBehaviour: MonsterBehaviourConfig{
+			Targeting: func(m *Mob) bool {
+				return !inDaylight(m)
+			},
+			Goals: func(_ *Mob, b *ai.Brain) {
+				b.AddGoal(3, &ai.MeleeAttack{})
+			},
+		}.New()},
+	}.New(SpiderType{}, pos)
+}
+
+// spiderBehaviour implements the behaviour of spiders. In addition to the
+// MonsterBehaviour, spiders climb up walls that they walk into.
+type spiderBehaviour struct {
+	*MonsterBehaviour
+}
+
+// Tick ...
+func (s spiderBehaviour) Tick(m *Mob) {
+	s.MonsterBehaviour.Tick(m)
+	if m.World() == nil || !m.CollidedHorizontally() {
+		return
+	}
+	vel := m.Velocity()
+	m.SetVelocity(mgl64.Vec3{vel[0], 0.2, vel[2]})
+	m.ResetFallDistance()
+}
+
+// spiderDrops returns the items dropped by a spider when it dies. Spider eyes
+// are only dropped if the spider was killed by a player.
+func spiderDrops(_ *Mob, src world.DamageSource) []item.Stack {
+	drops := []item.Stack{item.NewStack(item.String{}, rand.Intn(3)+lootingBonus(src))}
+	if killedByPlayer(src) && rand.Intn(3) == 0 {
+		drops = append(drops, item.NewStack(item.SpiderEye{}, 1+lootingBonus(src)))
+	}
+	return drops
+}
+
+// killedByPlayer checks if the world.DamageSource passed was caused by a
+// player, either directly or through a projectile.
+func killedByPlayer(src world.DamageSource) bool {
+	_, ok := killer(src).(interface{ GameMode() world.GameMode })
+	return ok
+}
+
+// killer returns the world.Entity that caused the world.DamageSource passed,
+// either directly or through a projectile. Nil is returned if no entity
+// caused it.
+func killer(src world.DamageSource) world.Entity {
+	switch s := src.(type) {
+	case AttackDamageSource:
+		return s.Attacker
+	case ProjectileDamageSource:
+		return s.Owner
+	}
+	return nil
+}
+
+// SpiderType is a world.EntityType implementation for spiders.
+type SpiderType struct{}
+
+func (SpiderType) EncodeEntity() string { return "minecraft:spider" }
+func (SpiderType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.7, 0, -0.7, 0.7, 0.9, 0.7)
+}
+
+func (SpiderType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMob(NewSpider(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (SpiderType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMob(e.(*Mob))
+}
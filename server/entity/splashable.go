@@ -61,6 +61,11 @@ func potionSplash(durMul float64, pot potion.Potion, linger bool) func(e *Ent, r
 				if w.Block(blockPos) == fire() {
 					w.SetBlock(blockPos, nil, nil)
 				}
+				for _, p := range []cube.Pos{result.BlockPosition(), blockPos} {
+					if e, ok := w.Block(p).(extinguishable); ok {
+						e.Extinguish(p, w)
+					}
+				}
 
 				for _, f := range cube.HorizontalFaces() {
 					if h := blockPos.Side(f); w.Block(h) == fire() {
@@ -76,3 +81,9 @@ func potionSplash(durMul float64, pot potion.Potion, linger bool) func(e *Ent, r
 		}
 	}
 }
+
+// extinguishable represents a block that may be extinguished, such as a lit campfire.
+type extinguishable interface {
+	// Extinguish extinguishes the block at the position passed. True is returned if the block was extinguished.
+	Extinguish(pos cube.Pos, w *world.World) bool
+}
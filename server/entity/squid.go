@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewSquid creates a new squid at the position passed. Squids swim around in
+// oceans and rivers and drop ink sacs when killed.
+func NewSquid(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:   world.MobCategoryWater,
+		MaxHealth:  10,
+		Speed:      0.06,
+		EyeHeight:  0.5,
+		Aquatic:    true,
+		Experience: animalExperience,
+		Drops:      squidDrops,
+		Behaviour:  AquaticBehaviourConfig{}.New(),
+	}.New(SquidType{}, pos)
+}
+
+// squidDrops returns the items dropped by a squid when it dies.
+func squidDrops(_ *Mob, src world.DamageSource) []item.Stack {
+	return []item.Stack{item.NewStack(item.InkSac{}, rand.Intn(3)+1+lootingBonus(src))}
+}
+
+// SquidType is a world.EntityType implementation for squids.
+type SquidType struct{}
+
+func (SquidType) EncodeEntity() string { return "minecraft:squid" }
+func (SquidType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.475, 0, -0.475, 0.475, 0.95, 0.475)
+}
+
+func (SquidType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeAquatic(NewSquid, data)
+}
+
+func (SquidType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeAquatic(e.(*Mob))
+}
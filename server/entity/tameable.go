@@ -0,0 +1,397 @@
+package entity
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/entity/pathfind"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+)
+
+// TameableBehaviourConfig holds optional parameters for a TameableBehaviour.
+type TameableBehaviourConfig struct {
+	// TameItem returns true if the item stack passed may be fed to an untamed
+	// animal to attempt to tame it.
+	TameItem func(s item.Stack) bool
+	// TameChance is the chance, 1 in TameChance, that feeding the TameItem to
+	// the animal tames it. If 0, a TameChance of 3 is used.
+	TameChance int
+	// Tempted specifies if untamed animals follow players holding the
+	// TameItem.
+	Tempted bool
+	// TamedHealth is the maximum health of the animal once it is tamed. If 0,
+	// the maximum health is not changed.
+	TamedHealth float64
+	// Food returns true if the item stack passed is food that a tamed animal
+	// eats. Feeding it heals the animal or makes it fall in love.
+	Food func(s item.Stack) bool
+	// Offspring creates the baby born when the parent Mob breeds with its
+	// partner. The baby is tamed by the owner of the parent.
+	Offspring func(parent, partner *Mob) *Mob
+	// Protective specifies if the animal defends its owner and attacks the
+	// targets of its owner once tamed, like wolves.
+	Protective bool
+	// Goals is called when the ai.Brain of the animal is created and may be
+	// used to add goals specific to the animal.
+	Goals func(m *Mob, b *ai.Brain)
+}
+
+// New creates a TameableBehaviour using the parameters in conf.
+func (conf TameableBehaviourConfig) New() *TameableBehaviour {
+	t := &TameableBehaviour{conf: conf, collar: item.ColourRed()}
+	t.AnimalBehaviour = AnimalBehaviourConfig{Food: conf.Food, Offspring: t.offspring}.New()
+	return t
+}
+
+// TameableBehaviour implements the behaviour of animals that may be tamed by
+// players, such as wolves and cats. Tamed animals follow their owner,
+// teleporting to it when far away, and may be ordered to sit by interacting
+// with them. Their collar may be dyed by the owner.
+type TameableBehaviour struct {
+	*AnimalBehaviour
+	conf TameableBehaviourConfig
+
+	tameMu         sync.Mutex
+	tamed, sitting bool
+	ownerID        uuid.UUID
+	owner          world.Entity
+	collar         item.Colour
+	// calm is true if the target and path of the ai.Brain of the animal
+	// should be cleared on the next tick.
+	calm bool
+}
+
+// Tamed checks if the animal was tamed by a player.
+func (t *TameableBehaviour) Tamed() bool {
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	return t.tamed
+}
+
+// OwnerUUID returns the UUID of the owner of the animal. False is returned if
+// the animal is not tamed.
+func (t *TameableBehaviour) OwnerUUID() (uuid.UUID, bool) {
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	return t.ownerID, t.tamed
+}
+
+// Owner returns the owner of the animal. False is returned if the animal is
+// not tamed or if its owner is not in the same world as the animal.
+func (t *TameableBehaviour) Owner() (world.Entity, bool) {
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	if !t.tamed || t.owner == nil || t.owner.World() == nil {
+		return nil, false
+	}
+	return t.owner, true
+}
+
+// Sitting checks if the animal was ordered to sit by its owner.
+func (t *TameableBehaviour) Sitting() bool {
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	return t.sitting
+}
+
+// Colour returns the colour of the collar of the animal.
+func (t *TameableBehaviour) Colour() item.Colour {
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	return t.collar
+}
+
+// Tame makes the entity passed the owner of the animal. The entity must have
+// a UUID() uuid.UUID method, like players. False is returned if it doesn't.
+func (t *TameableBehaviour) Tame(m *Mob, owner world.Entity) bool {
+	o, ok := owner.(interface{ UUID() uuid.UUID })
+	if !ok {
+		return false
+	}
+	t.tameMu.Lock()
+	t.tamed, t.ownerID, t.owner, t.calm = true, o.UUID(), owner, true
+	t.tameMu.Unlock()
+	if t.conf.TamedHealth > 0 {
+		m.SetMaxHealth(t.conf.TamedHealth)
+		m.Heal(t.conf.TamedHealth, FeedHealingSource{})
+	}
+	t.SetSitting(m, true)
+	return true
+}
+
+// SetSitting orders the animal to sit or to stand up. Sitting animals do not
+// move and do not follow their owner.
+func (t *TameableBehaviour) SetSitting(m *Mob, sitting bool) {
+	t.tameMu.Lock()
+	changed := t.sitting != sitting
+	t.sitting = sitting
+	if changed && sitting {
+		t.calm = true
+	}
+	t.tameMu.Unlock()
+	if changed {
+		m.updateState()
+	}
+}
+
+// Tick looks up the owner of the animal and ticks its AI. Sitting animals
+// stand up when attacked.
+func (t *TameableBehaviour) Tick(m *Mob) {
+	if t.brain == nil {
+		t.brain = t.newBrain(m)
+	}
+	t.tameMu.Lock()
+	calm, lost := t.calm, t.tamed && (t.owner == nil || t.owner.World() != m.World())
+	t.calm = false
+	t.tameMu.Unlock()
+
+	if calm {
+		t.brain.SetTarget(nil)
+		t.brain.Navigator().Stop()
+	}
+	if lost && t.brain.Ticks()%20 == 0 {
+		owner := t.findOwner(m)
+		t.tameMu.Lock()
+		t.owner = owner
+		t.tameMu.Unlock()
+	}
+	if _, ok := m.LastAttacker(); ok && t.Sitting() {
+		t.SetSitting(m, false)
+	}
+	t.AnimalBehaviour.Tick(m)
+}
+
+// Interact attempts to tame the animal if it is not tamed yet. Tamed animals
+// are healed by their food, fall in love when fed at full health and have
+// their collar dyed or are ordered to sit or stand up by their owner.
+func (t *TameableBehaviour) Interact(m *Mob, user item.User) bool {
+	held, left := user.HeldItems()
+	ownerID, tamed := t.OwnerUUID()
+	if !tamed {
+		if t.conf.TameItem == nil || !t.conf.TameItem(held) || t.Baby() {
+			return false
+		}
+		if !creative(user) {
+			user.SetHeldItems(held.Grow(-1), left)
+		}
+		chance := t.conf.TameChance
+		if chance == 0 {
+			chance = 3
+		}
+		success := rand.Intn(chance) == 0 && t.Tame(m, user)
+		for _, v := range m.viewers() {
+			v.ViewEntityAction(m, TameAction{Success: success})
+		}
+		return true
+	}
+	if t.conf.Food != nil && t.conf.Food(held) && m.Health() < m.MaxHealth() {
+		m.Heal(2, FeedHealingSource{})
+		if !creative(user) {
+			user.SetHeldItems(held.Grow(-1), left)
+		}
+		return true
+	}
+	if t.AnimalBehaviour.Interact(m, user) {
+		return true
+	}
+	if o, ok := user.(interface{ UUID() uuid.UUID }); !ok || o.UUID() != ownerID {
+		return false
+	}
+	if dye, ok := held.Item().(item.Dye); ok {
+		t.tameMu.Lock()
+		dyed := dye.Colour != t.collar
+		t.collar = dye.Colour
+		t.tameMu.Unlock()
+		if !dyed {
+			return false
+		}
+		m.updateState()
+		if !creative(user) {
+			user.SetHeldItems(held.Grow(-1), left)
+		}
+		return true
+	}
+	t.SetSitting(m, !t.Sitting())
+	return true
+}
+
+// offspring creates the baby of the animal using the Offspring function of
+// the config. The baby is tamed by the owner of the parent.
+func (t *TameableBehaviour) offspring(parent, partner *Mob) *Mob {
+	baby := t.conf.Offspring(parent, partner)
+	t.tameMu.Lock()
+	tamed, ownerID, owner := t.tamed, t.ownerID, t.owner
+	t.tameMu.Unlock()
+	if other, ok := baby.Behaviour().(interface{ tameable() *TameableBehaviour }); ok && tamed {
+		b := other.tameable()
+		b.tameMu.Lock()
+		b.tamed, b.ownerID, b.owner = true, ownerID, owner
+		b.tameMu.Unlock()
+		if t.conf.TamedHealth > 0 {
+			baby.SetMaxHealth(t.conf.TamedHealth)
+			baby.Heal(t.conf.TamedHealth, FeedHealingSource{})
+		}
+	}
+	return baby
+}
+
+// findOwner finds the owner of the animal in the world of the Mob passed. Nil
+// is returned if the owner is not in the world.
+func (t *TameableBehaviour) findOwner(m *Mob) world.Entity {
+	ownerID, _ := t.OwnerUUID()
+	for _, e := range m.World().Entities() {
+		if o, ok := e.(interface{ UUID() uuid.UUID }); ok && o.UUID() == ownerID {
+			return e
+		}
+	}
+	return nil
+}
+
+// newBrain creates the ai.Brain of the animal.
+func (t *TameableBehaviour) newBrain(m *Mob) *ai.Brain {
+	bb := m.Type().BBox(m)
+	b := ai.NewBrain(m, pathfind.Config{Width: bb.Width(), Height: bb.Height()})
+	b.AddSensor(ai.NearestPlayerSensor{})
+	b.AddSensor(ai.AttackerSensor{})
+
+	standing := func(*ai.Brain) bool { return !t.Sitting() }
+	notOwner := func(e world.Entity) bool {
+		owner, ok := t.Owner()
+		return !ok || e != owner
+	}
+
+	b.AddGoal(0, &ai.Float{})
+	b.AddGoal(1, &sitGoal{t: t})
+	if t.conf.Protective {
+		b.AddGoal(1, &ai.DefendOwner{Condition: standing, Filter: t.hostileTo})
+		b.AddGoal(2, &ai.AttackOwnerTarget{Condition: standing, Filter: t.hostileTo})
+		b.AddGoal(3, &ai.TargetAttacker{Filter: notOwner})
+		b.AddGoal(2, &ai.MeleeAttack{Speed: 1.2})
+	} else {
+		b.AddGoal(2, &ai.Flee{Speed: 1.25})
+	}
+	b.AddGoal(3, &ai.FollowOwner{Speed: 1.2})
+	b.AddGoal(4, &breedGoal{a: t.AnimalBehaviour})
+	if t.conf.Tempted && t.conf.TameItem != nil {
+		b.AddGoal(5, &ai.Tempt{Speed: 0.8, Items: func(s item.Stack) bool {
+			return !t.Tamed() && t.conf.TameItem(s)
+		}})
+	}
+	b.AddGoal(6, &ai.Wander{})
+	b.AddGoal(7, &ai.LookAtPlayer{})
+	if t.conf.Goals != nil {
+		t.conf.Goals(m, b)
+	}
+	return b
+}
+
+// hostileTo checks if a protective animal may attack the entity passed on
+// behalf of its owner. Creepers and animals tamed by the same owner are never
+// attacked.
+func (t *TameableBehaviour) hostileTo(e world.Entity) bool {
+	if _, ok := e.Type().(CreeperType); ok {
+		return false
+	}
+	if m, ok := e.(*Mob); ok {
+		if other, ok := m.Behaviour().(interface{ tameable() *TameableBehaviour }); ok {
+			id, tamed := other.tameable().OwnerUUID()
+			ownerID, _ := t.OwnerUUID()
+			return !tamed || id != ownerID
+		}
+	}
+	return true
+}
+
+// tameable returns the TameableBehaviour itself. It allows finding the
+// TameableBehaviour of animals with a MobBehaviour that embeds it.
+func (t *TameableBehaviour) tameable() *TameableBehaviour {
+	return t
+}
+
+// decodeNBT decodes the properties of the tameable animal from the NBT map
+// passed.
+func (t *TameableBehaviour) decodeNBT(m *Mob, data map[string]any) {
+	t.AnimalBehaviour.decodeNBT(data)
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	t.collar = item.Colours()[nbtconv.Uint8(data, "Color")%16]
+	if id, err := uuid.Parse(nbtconv.String(data, "OwnerUUID")); err == nil && nbtconv.Bool(data, "IsTamed") {
+		t.tamed, t.ownerID = true, id
+		t.sitting = nbtconv.Bool(data, "Sitting")
+		if t.conf.TamedHealth > 0 {
+			m.SetMaxHealth(t.conf.TamedHealth)
+		}
+	}
+}
+
+// encodeNBT encodes the properties of the tameable animal into the NBT map
+// passed.
+func (t *TameableBehaviour) encodeNBT(data map[string]any) map[string]any {
+	t.AnimalBehaviour.encodeNBT(data)
+	t.tameMu.Lock()
+	defer t.tameMu.Unlock()
+	data["Color"] = t.collar.Uint8()
+	data["IsTamed"] = t.tamed
+	data["Sitting"] = t.sitting
+	if t.tamed {
+		data["OwnerUUID"] = t.ownerID.String()
+	}
+	return data
+}
+
+// decodeTameable creates a tameable animal using the function passed and
+// decodes its properties from the NBT map passed.
+func decodeTameable(create func(pos mgl64.Vec3) *Mob, data map[string]any) *Mob {
+	m := decodeMob(create(nbtconv.Vec3(data, "Pos")), data)
+	if t, ok := m.Behaviour().(interface{ tameable() *TameableBehaviour }); ok {
+		t.tameable().decodeNBT(m, data)
+	}
+	return m
+}
+
+// encodeTameable encodes the properties of the tameable animal passed into an
+// NBT map.
+func encodeTameable(m *Mob) map[string]any {
+	data := encodeMob(m)
+	if t, ok := m.Behaviour().(interface{ tameable() *TameableBehaviour }); ok {
+		t.tameable().encodeNBT(data)
+	}
+	return data
+}
+
+// sitGoal is an ai.Goal that keeps a tamed animal in place while it was
+// ordered to sit.
+type sitGoal struct {
+	t *TameableBehaviour
+}
+
+// Flags ...
+func (g *sitGoal) Flags() ai.Flag {
+	return ai.FlagMove | ai.FlagJump
+}
+
+// CanStart ...
+func (g *sitGoal) CanStart(*ai.Brain) bool {
+	return g.t.Sitting()
+}
+
+// CanContinue ...
+func (g *sitGoal) CanContinue(*ai.Brain) bool {
+	return g.t.Sitting()
+}
+
+// Start ...
+func (g *sitGoal) Start(b *ai.Brain) {
+	b.Navigator().Stop()
+}
+
+// Stop ...
+func (g *sitGoal) Stop(*ai.Brain) {}
+
+// Tick ...
+func (g *sitGoal) Tick(*ai.Brain) {}
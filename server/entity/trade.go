@@ -0,0 +1,173 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/loot"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Trade is an offer of a villager to trade items with a player. The player
+// pays the Input, and optionally the SecondInput, in exchange for the Output.
+type Trade struct {
+	// Input is the item that the player has to pay for the trade. Its count
+	// is the base price of the trade, which is raised when the demand for the
+	// trade is high.
+	Input item.Stack
+	// SecondInput is an optional second item that the player has to pay for
+	// the trade.
+	SecondInput item.Stack
+	// Output is the item that the player receives from the trade.
+	Output item.Stack
+	// MaxUses is the number of times that the trade may be used before the
+	// villager has to restock.
+	MaxUses int
+	// Uses is the number of times that the trade was used since the villager
+	// last restocked.
+	Uses int
+	// Experience is the experience that the villager gains when the trade is
+	// used.
+	Experience int
+	// PriceMultiplier is the multiplier used to raise the price of the trade
+	// when the demand for it is high.
+	PriceMultiplier float64
+	// Tier is the level of the villager, 1-5, at which the trade was
+	// unlocked.
+	Tier int
+
+	demand int
+}
+
+// Price returns the Input of the trade with its count raised based on the
+// demand for the trade.
+func (t Trade) Price() item.Stack {
+	n := t.Input.Count()
+	extra := max(int(float64(n*t.demand)*t.PriceMultiplier), 0)
+	return t.Input.Grow(min(n+extra, t.Input.MaxCount()) - n)
+}
+
+// Disabled checks if the trade was used the maximum number of times and can
+// no longer be used until the villager restocks.
+func (t Trade) Disabled() bool {
+	return t.Uses >= t.MaxUses
+}
+
+// restock resets the uses of the trade and updates the demand for it based on
+// the number of times it was used.
+func (t *Trade) restock() {
+	t.demand = max(t.demand+t.Uses-(t.MaxUses-t.Uses), 0)
+	t.Uses = 0
+}
+
+// encodeNBT encodes the Trade into an NBT map.
+func (t Trade) encodeNBT() map[string]any {
+	m := map[string]any{
+		"buyA":            nbtconv.WriteItem(t.Input, true),
+		"sell":            nbtconv.WriteItem(t.Output, true),
+		"maxUses":         int32(t.MaxUses),
+		"uses":            int32(t.Uses),
+		"traderExp":       int32(t.Experience),
+		"priceMultiplier": float32(t.PriceMultiplier),
+		"tier":            int32(t.Tier),
+		"demand":          int32(t.demand),
+	}
+	if !t.SecondInput.Empty() {
+		m["buyB"] = nbtconv.WriteItem(t.SecondInput, true)
+	}
+	return m
+}
+
+// decodeTrade decodes a Trade from an NBT map.
+func decodeTrade(m map[string]any) Trade {
+	return Trade{
+		Input:           nbtconv.MapItem(m, "buyA"),
+		SecondInput:     nbtconv.MapItem(m, "buyB"),
+		Output:          nbtconv.MapItem(m, "sell"),
+		MaxUses:         int(nbtconv.Int32(m, "maxUses")),
+		Uses:            int(nbtconv.Int32(m, "uses")),
+		Experience:      int(nbtconv.Int32(m, "traderExp")),
+		PriceMultiplier: float64(nbtconv.Float32(m, "priceMultiplier")),
+		Tier:            int(nbtconv.Int32(m, "tier")),
+		demand:          int(nbtconv.Int32(m, "demand")),
+	}
+}
+
+// tradeFunc is a function that creates a Trade. Villagers pick random
+// tradeFuncs from the trade table of their profession when they level up.
+type tradeFunc func() Trade
+
+// buy returns a tradeFunc for a trade in which the villager buys n of the
+// item passed for one emerald.
+func buy(it world.Item, n, maxUses, xp int) tradeFunc {
+	return func() Trade {
+		return Trade{
+			Input:           item.NewStack(it, n),
+			Output:          item.NewStack(item.Emerald{}, 1),
+			MaxUses:         maxUses,
+			Experience:      xp,
+			PriceMultiplier: 0.05,
+		}
+	}
+}
+
+// sell returns a tradeFunc for a trade in which the villager sells n of the
+// item passed for a price in emeralds.
+func sell(it world.Item, n, price, maxUses, xp int) tradeFunc {
+	return func() Trade {
+		return Trade{
+			Input:           item.NewStack(item.Emerald{}, price),
+			Output:          item.NewStack(it, n),
+			MaxUses:         maxUses,
+			Experience:      xp,
+			PriceMultiplier: 0.05,
+		}
+	}
+}
+
+// process returns a tradeFunc for a trade in which the villager turns n of
+// the item passed and a price in emeralds into an output item.
+func process(in world.Item, n, price int, out world.Item, count, maxUses, xp int) tradeFunc {
+	return func() Trade {
+		return Trade{
+			Input:           item.NewStack(item.Emerald{}, price),
+			SecondInput:     item.NewStack(in, n),
+			Output:          item.NewStack(out, count),
+			MaxUses:         maxUses,
+			Experience:      xp,
+			PriceMultiplier: 0.05,
+		}
+	}
+}
+
+// sellEnchanted returns a tradeFunc for a trade in which the villager sells
+// the item passed with random enchantments for a price in emeralds.
+func sellEnchanted(it world.Item, price, maxUses, xp int) tradeFunc {
+	return func() Trade {
+		t := sell(it, 1, price, maxUses, xp)()
+		t.Output = loot.EnchantRandomly{}.Apply(t.Output, loot.Context{})
+		t.PriceMultiplier = 0.2
+		return t
+	}
+}
+
+// sellEnchantedBook returns a tradeFunc for a trade in which the villager
+// sells an enchanted book with a random enchantment. The price depends on the
+// level of the enchantment.
+func sellEnchantedBook(maxUses, xp int) tradeFunc {
+	return func() Trade {
+		book, lvl := loot.EnchantRandomly{Treasure: true}.Apply(item.NewStack(item.Book{}, 1), loot.Context{}), 1
+		if enchantments := book.Enchantments(); len(enchantments) > 0 {
+			lvl = enchantments[0].Level()
+		}
+		return Trade{
+			Input:           item.NewStack(item.Emerald{}, min(2+rand.Intn(5+lvl*10)+3*lvl, 64)),
+			SecondInput:     item.NewStack(item.Book{}, 1),
+			Output:          book,
+			MaxUses:         maxUses,
+			Experience:      xp,
+			PriceMultiplier: 0.2,
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package entity
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/entity/pathfind"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewVillager creates a new villager without a profession at the position
+// passed. The villager obtains a profession by claiming a workstation block
+// near it.
+func NewVillager(pos mgl64.Vec3) *Mob {
+	return NewVillagerWithProfession(pos, ProfessionNone())
+}
+
+// NewVillagerWithProfession creates a new villager with a VillagerProfession
+// at the position passed. The villager keeps its profession even if it has no
+// workstation.
+func NewVillagerWithProfession(pos mgl64.Vec3, p VillagerProfession) *Mob {
+	v := &VillagerBehaviour{profession: p, level: 1}
+	v.unlockTrades()
+	return MobConfig{
+		MaxHealth: 20,
+		Speed:     0.1,
+		EyeHeight: 1.62,
+		Behaviour: v,
+	}.New(VillagerType{}, pos)
+}
+
+// VillagerBehaviour implements the behaviour of villagers. Villagers with a
+// profession trade items with players. Using their trades makes villagers
+// gain experience, with which they level up and unlock new trades.
+type VillagerBehaviour struct {
+	brain *ai.Brain
+
+	mu         sync.Mutex
+	profession VillagerProfession
+	level, xp  int
+	trades     []Trade
+
+	workstation    cube.Pos
+	hasWorkstation bool
+
+	trading world.Entity
+	ticks   int64
+}
+
+// villagerLevelExperience holds the experience required to reach each of the
+// levels of villagers.
+var villagerLevelExperience = [5]int{0, 10, 70, 150, 250}
+
+// Profession returns the VillagerProfession of the villager.
+func (v *VillagerBehaviour) Profession() VillagerProfession {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.profession
+}
+
+// Level returns the level of the villager, ranging from 1 (novice) to 5
+// (master).
+func (v *VillagerBehaviour) Level() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.level
+}
+
+// TradeExperience returns the experience that the villager gained by trading.
+func (v *VillagerBehaviour) TradeExperience() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.xp
+}
+
+// LevelExperience returns the experience that the villager needs for each of
+// its levels.
+func (v *VillagerBehaviour) LevelExperience() [5]int {
+	return villagerLevelExperience
+}
+
+// Trades returns the trades currently offered by the villager.
+func (v *VillagerBehaviour) Trades() []Trade {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return slices.Clone(v.trades)
+}
+
+// SetTrades changes the trades offered by the villager. The trades are
+// replaced when the villager levels up.
+func (v *VillagerBehaviour) SetTrades(trades []Trade) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.trades = slices.Clone(trades)
+}
+
+// Workstation returns the position of the workstation claimed by the
+// villager. False is returned if the villager has no workstation.
+func (v *VillagerBehaviour) Workstation() (cube.Pos, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.workstation, v.hasWorkstation
+}
+
+// Trading returns the entity that is currently trading with the villager.
+// False is returned if no entity is trading with it.
+func (v *VillagerBehaviour) Trading() (world.Entity, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.trading, v.trading != nil
+}
+
+// StopTrading stops the entity passed from trading with the villager.
+func (v *VillagerBehaviour) StopTrading(e world.Entity) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.trading == e {
+		v.trading = nil
+	}
+}
+
+// Brain returns the ai.Brain of the villager. Nil is returned if the villager
+// was not ticked yet.
+func (v *VillagerBehaviour) Brain() *ai.Brain {
+	return v.brain
+}
+
+// Tick ticks the AI of the villager, makes it claim a workstation and
+// restocks its trades.
+func (v *VillagerBehaviour) Tick(m *Mob) {
+	if v.brain == nil {
+		v.brain = v.newBrain(m)
+	}
+	if t, ok := v.Trading(); ok && (t.World() != m.World() || t.Position().Sub(m.Position()).Len() > 8) {
+		v.StopTrading(t)
+	}
+	if v.ticks++; v.ticks%200 == 0 {
+		v.updateWorkstation(m)
+	}
+	if v.ticks%6000 == 0 {
+		v.mu.Lock()
+		for i := range v.trades {
+			v.trades[i].restock()
+		}
+		v.mu.Unlock()
+	}
+	v.brain.Tick()
+}
+
+// Interact opens the trading window for the user if the villager has a
+// profession and is not already trading with another entity.
+func (v *VillagerBehaviour) Interact(m *Mob, user item.User) bool {
+	opener, ok := user.(interface{ OpenTrading(e world.Entity) })
+	if !ok {
+		return false
+	}
+	v.mu.Lock()
+	if v.profession == ProfessionNone() || len(v.trades) == 0 || (v.trading != nil && v.trading != user) {
+		v.mu.Unlock()
+		return false
+	}
+	v.trading = user
+	v.mu.Unlock()
+	opener.OpenTrading(m)
+	return true
+}
+
+// UseTrade uses the trade at the index passed. The villager gains the
+// experience of the trade, possibly levelling up, and experience orbs are
+// dropped. False is returned if the trade does not exist or is disabled.
+func (v *VillagerBehaviour) UseTrade(m *Mob, index int) bool {
+	v.mu.Lock()
+	if index < 0 || index >= len(v.trades) || v.trades[index].Disabled() {
+		v.mu.Unlock()
+		return false
+	}
+	t := &v.trades[index]
+	t.Uses++
+	v.xp += t.Experience
+
+	xp := rand.Intn(4) + 3
+	if v.level < 5 && v.xp >= villagerLevelExperience[v.level] {
+		v.level++
+		v.unlockTrades()
+		xp += 5
+	}
+	v.mu.Unlock()
+	m.updateState()
+
+	w, pos := m.World(), m.Position().Add(mgl64.Vec3{0, 0.5})
+	for _, orb := range NewExperienceOrbs(pos, xp) {
+		w.AddEntity(orb)
+	}
+	return true
+}
+
+// unlockTrades adds two random trades for the current level of the villager
+// from the trade table of its profession. v.mu must be held when calling
+// unlockTrades on a villager that was added to a world.
+func (v *VillagerBehaviour) unlockTrades() {
+	table := v.profession.trades()[v.level-1]
+	for _, i := range rand.Perm(len(table))[:min(2, len(table))] {
+		t := table[i]()
+		t.Tier = v.level
+		v.trades = append(v.trades, t)
+	}
+}
+
+// updateWorkstation makes sure the workstation of the villager still exists.
+// If the villager does not have a workstation, it attempts to claim one near
+// it and takes on the profession that belongs to it.
+func (v *VillagerBehaviour) updateWorkstation(m *Mob) {
+	w := m.World()
+	profession, workstation, ok := v.Profession(), cube.Pos{}, false
+	if workstation, ok = v.Workstation(); ok {
+		if p, ok := ProfessionByWorkstation(w.Block(workstation)); ok && p == profession {
+			return
+		}
+		v.mu.Lock()
+		v.hasWorkstation = false
+		// Villagers that have never traded lose their profession when their
+		// workstation is removed.
+		lost := v.level == 1 && v.xp == 0
+		if lost {
+			v.profession, v.trades = ProfessionNone(), nil
+		}
+		v.mu.Unlock()
+		if lost {
+			m.updateState()
+		}
+		return
+	}
+	origin := cube.PosFromVec3(m.Position())
+	for x := -16; x <= 16; x++ {
+		for y := -4; y <= 4; y++ {
+			for z := -16; z <= 16; z++ {
+				pos := origin.Add(cube.Pos{x, y, z})
+				p, ok := ProfessionByWorkstation(w.Block(pos))
+				if !ok || (profession != ProfessionNone() && p != profession) || workstationClaimed(w, pos) {
+					continue
+				}
+				v.mu.Lock()
+				v.workstation, v.hasWorkstation = pos, true
+				claimed := v.profession == ProfessionNone()
+				if claimed {
+					v.profession, v.trades = p, nil
+					v.unlockTrades()
+				}
+				v.mu.Unlock()
+				if claimed {
+					m.updateState()
+				}
+				return
+			}
+		}
+	}
+}
+
+// workstationClaimed checks if the workstation at the position passed was
+// claimed by any villager near it.
+func workstationClaimed(w *world.World, pos cube.Pos) bool {
+	for _, e := range w.EntitiesWithin(cube.Box(-48, -48, -48, 48, 48, 48).Translate(pos.Vec3()), nil) {
+		if m, ok := e.(*Mob); ok {
+			if other, ok := m.Behaviour().(*VillagerBehaviour); ok {
+				if workstation, ok := other.Workstation(); ok && workstation == pos {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// newBrain creates the ai.Brain of the villager.
+func (v *VillagerBehaviour) newBrain(m *Mob) *ai.Brain {
+	bb := m.Type().BBox(m)
+	b := ai.NewBrain(m, pathfind.Config{Width: bb.Width(), Height: bb.Height(), CanOpenDoors: true})
+	b.AddSensor(ai.NearestPlayerSensor{})
+	b.AddSensor(ai.AttackerSensor{})
+
+	b.AddGoal(0, &ai.Float{})
+	b.AddGoal(1, &ai.Flee{Speed: 1.5})
+	b.AddGoal(2, &lookAtTraderGoal{v: v})
+	b.AddGoal(6, &ai.Wander{Speed: 0.6})
+	b.AddGoal(7, &ai.LookAtPlayer{})
+	return b
+}
+
+// decodeNBT decodes the properties of the villager from the NBT map passed.
+func (v *VillagerBehaviour) decodeNBT(data map[string]any) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.profession = VillagerProfession{villagerProfession(nbtconv.Uint8(data, "PreferredProfession"))}
+	v.level = max(int(nbtconv.Int32(data, "TradeTier"))+1, 1)
+	v.xp = int(nbtconv.Int32(data, "TradeExperience"))
+	v.trades = nil
+	if offers, ok := data["Offers"].(map[string]any); ok {
+		for _, t := range nbtconv.Slice[any](offers, "Recipes") {
+			if m, ok := t.(map[string]any); ok {
+				v.trades = append(v.trades, decodeTrade(m))
+			}
+		}
+	}
+	if _, ok := data["Workstation"]; ok {
+		v.workstation, v.hasWorkstation = nbtconv.Pos(data, "Workstation"), true
+	}
+}
+
+// encodeNBT encodes the properties of the villager into the NBT map passed.
+func (v *VillagerBehaviour) encodeNBT(data map[string]any) map[string]any {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	recipes := make([]map[string]any, 0, len(v.trades))
+	for _, t := range v.trades {
+		recipes = append(recipes, t.encodeNBT())
+	}
+	data["PreferredProfession"] = v.profession.Uint8()
+	data["TradeTier"] = int32(v.level - 1)
+	data["TradeExperience"] = int32(v.xp)
+	data["Offers"] = map[string]any{"Recipes": recipes}
+	if v.hasWorkstation {
+		data["Workstation"] = nbtconv.PosToInt32Slice(v.workstation)
+	}
+	return data
+}
+
+// lookAtTraderGoal is an ai.Goal that makes a villager stand still and look at
+// the entity that is trading with it.
+type lookAtTraderGoal struct {
+	v *VillagerBehaviour
+}
+
+// Flags ...
+func (g *lookAtTraderGoal) Flags() ai.Flag {
+	return ai.FlagMove | ai.FlagLook
+}
+
+// CanStart ...
+func (g *lookAtTraderGoal) CanStart(*ai.Brain) bool {
+	_, ok := g.v.Trading()
+	return ok
+}
+
+// CanContinue ...
+func (g *lookAtTraderGoal) CanContinue(*ai.Brain) bool {
+	_, ok := g.v.Trading()
+	return ok
+}
+
+// Start ...
+func (g *lookAtTraderGoal) Start(b *ai.Brain) {
+	b.Navigator().Stop()
+}
+
+// Stop ...
+func (g *lookAtTraderGoal) Stop(*ai.Brain) {}
+
+// Tick ...
+func (g *lookAtTraderGoal) Tick(b *ai.Brain) {
+	if t, ok := g.v.Trading(); ok {
+		b.Mob().(*Mob).LookAt(EyePosition(t))
+	}
+}
+
+// VillagerType is a world.EntityType implementation for villagers.
+type VillagerType struct{}
+
+func (VillagerType) EncodeEntity() string { return "minecraft:villager_v2" }
+func (VillagerType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.9, 0.3)
+}
+
+func (VillagerType) DecodeNBT(data map[string]any) world.Entity {
+	m := decodeMob(NewVillager(nbtconv.Vec3(data, "Pos")), data)
+	m.Behaviour().(*VillagerBehaviour).decodeNBT(data)
+	return m
+}
+
+func (VillagerType) EncodeNBT(e world.Entity) map[string]any {
+	m := e.(*Mob)
+	return m.Behaviour().(*VillagerBehaviour).encodeNBT(encodeMob(m))
+}
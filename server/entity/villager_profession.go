@@ -0,0 +1,269 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// VillagerProfession is the profession of a villager. Villagers without a
+// profession obtain one by claiming a workstation block near them.
+type VillagerProfession struct {
+	villagerProfession
+}
+
+// ProfessionNone returns the profession of villagers that have not yet claimed
+// a workstation.
+func ProfessionNone() VillagerProfession {
+	return VillagerProfession{0}
+}
+
+// ProfessionFarmer returns the farmer profession, which is obtained by
+// claiming a composter.
+func ProfessionFarmer() VillagerProfession {
+	return VillagerProfession{1}
+}
+
+// ProfessionFisherman returns the fisherman profession, which is obtained by
+// claiming a barrel.
+func ProfessionFisherman() VillagerProfession {
+	return VillagerProfession{2}
+}
+
+// ProfessionShepherd returns the shepherd profession, which is obtained by
+// claiming a loom.
+func ProfessionShepherd() VillagerProfession {
+	return VillagerProfession{3}
+}
+
+// ProfessionFletcher returns the fletcher profession, which is obtained by
+// claiming a fletching table.
+func ProfessionFletcher() VillagerProfession {
+	return VillagerProfession{4}
+}
+
+// ProfessionLibrarian returns the librarian profession, which is obtained by
+// claiming a lectern.
+func ProfessionLibrarian() VillagerProfession {
+	return VillagerProfession{5}
+}
+
+// ProfessionArmourer returns the armourer profession, which is obtained by
+// claiming a blast furnace.
+func ProfessionArmourer() VillagerProfession {
+	return VillagerProfession{8}
+}
+
+// ProfessionWeaponsmith returns the weaponsmith profession, which is obtained
+// by claiming a grindstone.
+func ProfessionWeaponsmith() VillagerProfession {
+	return VillagerProfession{9}
+}
+
+// ProfessionToolsmith returns the toolsmith profession, which is obtained by
+// claiming a smithing table.
+func ProfessionToolsmith() VillagerProfession {
+	return VillagerProfession{10}
+}
+
+// ProfessionButcher returns the butcher profession, which is obtained by
+// claiming a smoker.
+func ProfessionButcher() VillagerProfession {
+	return VillagerProfession{11}
+}
+
+// ProfessionMason returns the mason profession, which is obtained by claiming
+// a stonecutter.
+func ProfessionMason() VillagerProfession {
+	return VillagerProfession{13}
+}
+
+// VillagerProfessions returns all villager professions, excluding
+// ProfessionNone.
+func VillagerProfessions() []VillagerProfession {
+	return []VillagerProfession{
+		ProfessionFarmer(), ProfessionFisherman(), ProfessionShepherd(), ProfessionFletcher(), ProfessionLibrarian(),
+		ProfessionArmourer(), ProfessionWeaponsmith(), ProfessionToolsmith(), ProfessionButcher(), ProfessionMason(),
+	}
+}
+
+// ProfessionByWorkstation returns the VillagerProfession obtained by claiming
+// the workstation block passed. False is returned if the block is not a
+// workstation.
+func ProfessionByWorkstation(b world.Block) (VillagerProfession, bool) {
+	switch b.(type) {
+	case block.Composter:
+		return ProfessionFarmer(), true
+	case block.Barrel:
+		return ProfessionFisherman(), true
+	case block.Loom:
+		return ProfessionShepherd(), true
+	case block.FletchingTable:
+		return ProfessionFletcher(), true
+	case block.Lectern:
+		return ProfessionLibrarian(), true
+	case block.BlastFurnace:
+		return ProfessionArmourer(), true
+	case block.Grindstone:
+		return ProfessionWeaponsmith(), true
+	case block.SmithingTable:
+		return ProfessionToolsmith(), true
+	case block.Smoker:
+		return ProfessionButcher(), true
+	case block.Stonecutter:
+		return ProfessionMason(), true
+	}
+	return ProfessionNone(), false
+}
+
+type villagerProfession uint8
+
+// Uint8 returns the profession as a uint8.
+func (p villagerProfession) Uint8() uint8 {
+	return uint8(p)
+}
+
+// Name returns the name of the profession as it is displayed in the trading
+// window.
+func (p villagerProfession) Name() string {
+	switch p {
+	case 1:
+		return "Farmer"
+	case 2:
+		return "Fisherman"
+	case 3:
+		return "Shepherd"
+	case 4:
+		return "Fletcher"
+	case 5:
+		return "Librarian"
+	case 8:
+		return "Armorer"
+	case 9:
+		return "Weaponsmith"
+	case 10:
+		return "Toolsmith"
+	case 11:
+		return "Butcher"
+	case 13:
+		return "Mason"
+	}
+	return "Villager"
+}
+
+// String ...
+func (p villagerProfession) String() string {
+	switch p {
+	case 0:
+		return "none"
+	case 1:
+		return "farmer"
+	case 2:
+		return "fisherman"
+	case 3:
+		return "shepherd"
+	case 4:
+		return "fletcher"
+	case 5:
+		return "librarian"
+	case 8:
+		return "armorer"
+	case 9:
+		return "weaponsmith"
+	case 10:
+		return "toolsmith"
+	case 11:
+		return "butcher"
+	case 13:
+		return "mason"
+	}
+	panic("unknown villager profession")
+}
+
+// trades returns the trade table of the profession: for every villager level,
+// the tradeFuncs from which the trades unlocked at that level are picked.
+func (p villagerProfession) trades() [5][]tradeFunc {
+	switch p {
+	case 1:
+		return [5][]tradeFunc{
+			{buy(item.Wheat{}, 20, 16, 2), buy(block.Potato{}, 26, 16, 2), buy(block.Carrot{}, 22, 16, 2), buy(item.Beetroot{}, 15, 16, 2), sell(item.Bread{}, 6, 1, 16, 1)},
+			{buy(block.Pumpkin{}, 6, 12, 10), sell(item.PumpkinPie{}, 4, 1, 12, 5), sell(item.Apple{}, 4, 1, 16, 5)},
+			{sell(item.Cookie{}, 18, 3, 12, 10), buy(block.Melon{}, 4, 12, 20)},
+			{sell(block.Cake{}, 1, 1, 12, 15)},
+			{sell(item.GoldenCarrot{}, 3, 3, 12, 30), sell(item.GlisteringMelonSlice{}, 3, 4, 12, 30)},
+		}
+	case 2:
+		return [5][]tradeFunc{
+			{buy(item.String{}, 20, 16, 2), buy(item.Coal{}, 10, 16, 2), process(item.Cod{}, 6, 1, item.Cod{Cooked: true}, 6, 16, 1)},
+			{buy(item.Cod{}, 15, 16, 10), process(item.Salmon{}, 6, 1, item.Salmon{Cooked: true}, 6, 16, 5)},
+			{buy(item.Salmon{}, 13, 16, 20)},
+			{buy(item.TropicalFish{}, 6, 12, 30)},
+			{buy(item.Pufferfish{}, 4, 12, 30)},
+		}
+	case 3:
+		return [5][]tradeFunc{
+			{buy(block.Wool{Colour: item.ColourWhite()}, 18, 16, 2), buy(block.Wool{Colour: item.ColourBrown()}, 18, 16, 2), buy(block.Wool{Colour: item.ColourBlack()}, 18, 16, 2), buy(block.Wool{Colour: item.ColourGrey()}, 18, 16, 2), sell(item.Shears{}, 1, 2, 12, 1)},
+			{buy(item.Dye{Colour: item.ColourWhite()}, 12, 16, 10), buy(item.Dye{Colour: item.ColourGrey()}, 12, 16, 10), buy(item.Dye{Colour: item.ColourBlack()}, 12, 16, 10), sell(block.Wool{Colour: item.ColourRed()}, 1, 1, 16, 5), sell(block.Wool{Colour: item.ColourBlue()}, 1, 1, 16, 5)},
+			{buy(item.Dye{Colour: item.ColourYellow()}, 12, 16, 20), buy(item.Dye{Colour: item.ColourLightBlue()}, 12, 16, 20), sell(block.Carpet{Colour: item.ColourWhite()}, 4, 1, 16, 10), sell(block.Carpet{Colour: item.ColourGreen()}, 4, 1, 16, 10)},
+			{buy(item.Dye{Colour: item.ColourPink()}, 12, 16, 30), buy(item.Dye{Colour: item.ColourPurple()}, 12, 16, 30), sell(block.Bed{Colour: item.ColourWhite()}, 1, 3, 12, 15), sell(block.Bed{Colour: item.ColourRed()}, 1, 3, 12, 15)},
+			{sell(block.Bed{Colour: item.ColourLightBlue()}, 1, 3, 12, 30), sell(block.Bed{Colour: item.ColourYellow()}, 1, 3, 12, 30)},
+		}
+	case 4:
+		return [5][]tradeFunc{
+			{buy(item.Stick{}, 32, 16, 2), sell(item.Arrow{}, 16, 1, 12, 1), process(block.Gravel{}, 10, 1, item.Flint{}, 10, 12, 1)},
+			{buy(item.Flint{}, 26, 12, 10), sell(item.Bow{}, 1, 2, 12, 5)},
+			{buy(item.String{}, 14, 16, 20)},
+			{buy(item.Feather{}, 24, 16, 30), sellEnchanted(item.Bow{}, 7, 3, 15)},
+			{sellEnchanted(item.Bow{}, 12, 3, 30)},
+		}
+	case 5:
+		return [5][]tradeFunc{
+			{buy(item.Paper{}, 24, 16, 2), sellEnchantedBook(12, 1), sell(block.Bookshelf{}, 1, 9, 12, 1)},
+			{buy(item.Book{}, 4, 12, 10), sellEnchantedBook(12, 5), sell(block.Lantern{}, 1, 1, 12, 5)},
+			{buy(item.InkSac{}, 5, 12, 20), sellEnchantedBook(12, 10), sell(block.Glass{}, 4, 1, 12, 10)},
+			{buy(item.BookAndQuill{}, 2, 12, 30), sellEnchantedBook(12, 15), sell(item.Clock{}, 1, 5, 12, 15), sell(item.Compass{}, 1, 4, 12, 15)},
+			{sellEnchantedBook(12, 30)},
+		}
+	case 8:
+		return [5][]tradeFunc{
+			{buy(item.Coal{}, 15, 16, 2), sell(item.Helmet{Tier: item.ArmourTierIron{}}, 1, 5, 12, 1), sell(item.Chestplate{Tier: item.ArmourTierIron{}}, 1, 9, 12, 1), sell(item.Leggings{Tier: item.ArmourTierIron{}}, 1, 7, 12, 1), sell(item.Boots{Tier: item.ArmourTierIron{}}, 1, 4, 12, 1)},
+			{buy(item.IronIngot{}, 4, 12, 10), sell(item.Boots{Tier: item.ArmourTierChain{}}, 1, 1, 12, 5), sell(item.Leggings{Tier: item.ArmourTierChain{}}, 1, 3, 12, 5)},
+			{sell(item.Helmet{Tier: item.ArmourTierChain{}}, 1, 1, 12, 10), sell(item.Chestplate{Tier: item.ArmourTierChain{}}, 1, 4, 12, 10)},
+			{buy(item.Diamond{}, 1, 12, 30), sellEnchanted(item.Leggings{Tier: item.ArmourTierDiamond{}}, 14, 3, 15), sellEnchanted(item.Boots{Tier: item.ArmourTierDiamond{}}, 8, 3, 15)},
+			{sellEnchanted(item.Helmet{Tier: item.ArmourTierDiamond{}}, 8, 3, 30), sellEnchanted(item.Chestplate{Tier: item.ArmourTierDiamond{}}, 16, 3, 30)},
+		}
+	case 9:
+		return [5][]tradeFunc{
+			{buy(item.Coal{}, 15, 16, 2), sell(item.Axe{Tier: item.ToolTierIron}, 1, 3, 12, 1), sellEnchanted(item.Sword{Tier: item.ToolTierIron}, 2, 3, 1)},
+			{buy(item.IronIngot{}, 4, 12, 10)},
+			{buy(item.Flint{}, 24, 12, 20)},
+			{buy(item.Diamond{}, 1, 12, 30), sellEnchanted(item.Axe{Tier: item.ToolTierDiamond}, 12, 3, 15)},
+			{sellEnchanted(item.Sword{Tier: item.ToolTierDiamond}, 8, 3, 30)},
+		}
+	case 10:
+		return [5][]tradeFunc{
+			{buy(item.Coal{}, 15, 16, 2), sell(item.Axe{Tier: item.ToolTierStone}, 1, 1, 12, 1), sell(item.Shovel{Tier: item.ToolTierStone}, 1, 1, 12, 1), sell(item.Pickaxe{Tier: item.ToolTierStone}, 1, 1, 12, 1), sell(item.Hoe{Tier: item.ToolTierStone}, 1, 1, 12, 1)},
+			{buy(item.IronIngot{}, 4, 12, 10)},
+			{buy(item.Flint{}, 30, 12, 20), sellEnchanted(item.Axe{Tier: item.ToolTierIron}, 1, 3, 10), sellEnchanted(item.Shovel{Tier: item.ToolTierIron}, 2, 3, 10), sellEnchanted(item.Pickaxe{Tier: item.ToolTierIron}, 3, 3, 10), sell(item.Hoe{Tier: item.ToolTierDiamond}, 1, 4, 3, 10)},
+			{buy(item.Diamond{}, 1, 12, 30), sellEnchanted(item.Axe{Tier: item.ToolTierDiamond}, 12, 3, 15), sellEnchanted(item.Shovel{Tier: item.ToolTierDiamond}, 5, 3, 15)},
+			{sellEnchanted(item.Pickaxe{Tier: item.ToolTierDiamond}, 13, 3, 30)},
+		}
+	case 11:
+		return [5][]tradeFunc{
+			{buy(item.Chicken{}, 14, 16, 2), buy(item.Porkchop{}, 7, 16, 2), buy(item.Rabbit{}, 4, 16, 2), sell(item.RabbitStew{}, 1, 1, 12, 1)},
+			{buy(item.Coal{}, 15, 16, 10), sell(item.Porkchop{Cooked: true}, 5, 1, 16, 5), sell(item.Chicken{Cooked: true}, 8, 1, 16, 5)},
+			{buy(item.Mutton{}, 7, 16, 20), buy(item.Beef{}, 10, 16, 20)},
+			{buy(block.DriedKelp{}, 10, 12, 30)},
+			{sell(item.Mutton{Cooked: true}, 5, 1, 16, 30), sell(item.Beef{Cooked: true}, 5, 1, 16, 30)},
+		}
+	case 13:
+		return [5][]tradeFunc{
+			{buy(item.ClayBall{}, 10, 16, 2), sell(item.Brick{}, 10, 1, 16, 1)},
+			{buy(block.Stone{}, 20, 16, 10), sell(block.StoneBricks{}, 4, 1, 16, 5)},
+			{buy(block.Granite{}, 16, 16, 20), buy(block.Andesite{}, 16, 16, 20), buy(block.Diorite{}, 16, 16, 20), sell(block.Andesite{Polished: true}, 4, 1, 16, 10), sell(block.Granite{Polished: true}, 4, 1, 16, 10), sell(block.Diorite{Polished: true}, 4, 1, 16, 10)},
+			{buy(item.NetherQuartz{}, 12, 12, 30), sell(block.Terracotta{}, 1, 1, 12, 15), sell(block.GlazedTerracotta{Colour: item.ColourOrange()}, 1, 1, 12, 15)},
+			{sell(block.Quartz{}, 1, 1, 12, 30), sell(block.QuartzPillar{}, 1, 1, 12, 30)},
+		}
+	}
+	return [5][]tradeFunc{}
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewWolf creates a new untamed adult wolf at the position passed. Wolves are
+// tamed by feeding them bones. Tamed wolves defend their owner and attack the
+// entities that their owner attacks.
+func NewWolf(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:     world.MobCategoryCreature,
+		MaxHealth:    8,
+		Speed:        0.12,
+		EyeHeight:    0.68,
+		AttackDamage: 4,
+		Experience:   animalExperience,
+		Behaviour: &wolfBehaviour{TameableBehaviour: TameableBehaviourConfig{
+			TameItem:    func(s item.Stack) bool { _, ok := s.Item().(item.Bone); return ok },
+			TamedHealth: 20,
+			Food:        wolfFood,
+			Offspring:   func(parent, _ *Mob) *Mob { return NewWolf(parent.Position()) },
+			Protective:  true,
+		}.New()},
+	}.New(WolfType{}, pos)
+}
+
+// wolfBehaviour is the MobBehaviour of wolves.
+type wolfBehaviour struct {
+	*TameableBehaviour
+}
+
+// Angry checks if the wolf is currently attacking a target.
+func (w *wolfBehaviour) Angry() bool {
+	if w.brain == nil {
+		return false
+	}
+	_, ok := w.brain.Target()
+	return ok
+}
+
+// wolfFood checks if the item stack passed is meat, the food of tamed wolves.
+func wolfFood(s item.Stack) bool {
+	switch s.Item().(type) {
+	case item.Beef, item.Chicken, item.Mutton, item.Porkchop, item.Rabbit, item.RottenFlesh:
+		return true
+	}
+	return false
+}
+
+// WolfType is a world.EntityType implementation for wolves.
+type WolfType struct{}
+
+func (WolfType) EncodeEntity() string { return "minecraft:wolf" }
+func (WolfType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.6, 0.85)
+}
+
+func (WolfType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeTameable(NewWolf, data)
+}
+
+func (WolfType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeTameable(e.(*Mob))
+}
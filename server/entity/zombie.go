@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/ai"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewZombie creates a new zombie at the position passed. Zombies attack
+// players in melee and burn in daylight.
+func NewZombie(pos mgl64.Vec3) *Mob {
+	return MobConfig{
+		Category:     world.MobCategoryMonster,
+		MaxHealth:    20,
+		Speed:        0.115,
+		AttackDamage: 3,
+		Undead:       true,
+		Experience:   monsterExperience,
+		Drops:        zombieDrops,
+		Behaviour: MonsterBehaviourConfig{
+			BurnsInDaylight: true,
+			Goals: func(_ *Mob, b *ai.Brain) {
+				b.AddGoal(3, &ai.MeleeAttack{})
+			},
+		}.New(),
+	}.New(ZombieType{}, pos)
+}
+
+// zombieDrops returns the items dropped by a zombie when it dies.
+func zombieDrops(_ *Mob, src world.DamageSource) []item.Stack {
+	drops := []item.Stack{item.NewStack(item.RottenFlesh{}, rand.Intn(3)+lootingBonus(src))}
+	if rand.Float64() < 0.025+(enchantment.Looting{}).ChanceBonus(lootingLevel(src)) {
+		switch rand.Intn(3) {
+		case 0:
+			drops = append(drops, item.NewStack(item.IronIngot{}, 1))
+		case 1:
+			drops = append(drops, item.NewStack(block.Carrot{}, 1))
+		case 2:
+			drops = append(drops, item.NewStack(block.Potato{}, 1))
+		}
+	}
+	return drops
+}
+
+// monsterExperience returns the experience dropped by a monster when it is
+// killed: 5 experience, plus 1-3 for every piece of equipment.
+func monsterExperience(m *Mob) int {
+	xp := 5
+	mainHand, offHand := m.HeldItems()
+	for _, it := range append(m.Armour().Items(), mainHand, offHand) {
+		if !it.Empty() {
+			xp += rand.Intn(3) + 1
+		}
+	}
+	return xp
+}
+
+// ZombieType is a world.EntityType implementation for zombies.
+type ZombieType struct{}
+
+func (ZombieType) EncodeEntity() string { return "minecraft:zombie" }
+func (ZombieType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.95, 0.3)
+}
+
+func (ZombieType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMob(NewZombie(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (ZombieType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMob(e.(*Mob))
+}
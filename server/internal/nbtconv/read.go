@@ -8,6 +8,7 @@ import (
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"golang.org/x/exp/constraints"
+	"reflect"
 	"time"
 )
 
@@ -86,6 +87,18 @@ func Slice[T any](m map[string]any, k string) []T {
 	return v
 }
 
+// Bytes reads a byte array value from a map at key k and returns it as a
+// []byte.
+func Bytes(m map[string]any, k string) []byte {
+	v := reflect.ValueOf(m[k])
+	if v.Kind() != reflect.Array || v.Type().Elem().Kind() != reflect.Uint8 {
+		return nil
+	}
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return b
+}
+
 // Vec3 converts x, y and z values in an NBT map to an mgl64.Vec3.
 func Vec3(x map[string]any, k string) mgl64.Vec3 {
 	if i, ok := x[k].([]any); ok {
@@ -6,6 +6,7 @@ import (
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/chunk"
+	"reflect"
 	"sort"
 )
 
@@ -45,6 +46,14 @@ func WriteBlock(b world.Block) map[string]any {
 	}
 }
 
+// ByteArray converts a []byte into a byte array so that it is encoded as a
+// TAG_ByteArray rather than a TAG_List.
+func ByteArray(b []byte) any {
+	v := reflect.New(reflect.ArrayOf(len(b), reflect.TypeOf(byte(0)))).Elem()
+	reflect.Copy(v, reflect.ValueOf(b))
+	return v.Interface()
+}
+
 // writeItemStack writes the name, metadata value, count and NBT of an item to a map ready for NBT encoding.
 func writeItemStack(m, t map[string]any, s item.Stack) {
 	m["Name"], m["Damage"] = s.Item().EncodeItem()
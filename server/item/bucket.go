@@ -12,6 +12,19 @@ import (
 type BucketContent struct {
 	liquid world.Liquid
 	milk   bool
+	entity BucketableEntityType
+}
+
+// BucketableEntityType is a world.EntityType of an entity that may be captured
+// in a bucket of water, such as a fish.
+type BucketableEntityType interface {
+	world.EntityType
+	// BucketName returns the name of the entity as used in the name of the
+	// bucket holding it, such as "cod" for "minecraft:cod_bucket".
+	BucketName() string
+	// FromBucket creates a new entity of this type at the position passed,
+	// after it was released from a bucket.
+	FromBucket(pos mgl64.Vec3) world.Entity
 }
 
 // LiquidBucketContent returns a new BucketContent with the liquid passed in.
@@ -24,16 +37,31 @@ func MilkBucketContent() BucketContent {
 	return BucketContent{milk: true}
 }
 
+// EntityBucketContent returns a new BucketContent with the liquid passed that
+// additionally holds an entity of the type passed, released when the liquid is
+// placed.
+func EntityBucketContent(l world.Liquid, t BucketableEntityType) BucketContent {
+	return BucketContent{liquid: l, entity: t}
+}
+
 // Liquid returns the world.Liquid that a Bucket with this BucketContent places.
 // If this BucketContent does not place a liquid block, false is returned.
 func (b BucketContent) Liquid() (world.Liquid, bool) {
 	return b.liquid, b.liquid != nil
 }
 
+// Entity returns the type of the entity held by a Bucket with this
+// BucketContent. If no entity is held, false is returned.
+func (b BucketContent) Entity() (BucketableEntityType, bool) {
+	return b.entity, b.entity != nil
+}
+
 // String converts the BucketContent to a string.
 func (b BucketContent) String() string {
 	if b.milk {
 		return "milk"
+	} else if b.entity != nil {
+		return b.entity.BucketName()
 	} else if b.liquid != nil {
 		return b.liquid.LiquidType()
 	}
@@ -110,12 +138,17 @@ func (b Bucket) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.
 	if bl := w.Block(pos); canDisplace(bl, liq) || replaceableWith(bl, liq) {
 		w.SetLiquid(pos, liq)
 	} else if bl := w.Block(pos.Side(face)); canDisplace(bl, liq) || replaceableWith(bl, liq) {
-		w.SetLiquid(pos.Side(face), liq)
+		pos = pos.Side(face)
+		w.SetLiquid(pos, liq)
 	} else {
 		return false
 	}
-
-	w.PlaySound(pos.Vec3Centre(), sound.BucketEmpty{Liquid: b.Content.liquid})
+	if t, ok := b.Content.Entity(); ok {
+		w.AddEntity(t.FromBucket(pos.Vec3Middle()))
+		w.PlaySound(pos.Vec3Centre(), sound.BucketEmptyFish{})
+	} else {
+		w.PlaySound(pos.Vec3Centre(), sound.BucketEmpty{Liquid: b.Content.liquid})
+	}
 	ctx.NewItem = NewStack(Bucket{}, 1)
 	ctx.NewItemSurvivalOnly = true
 	ctx.SubtractFromCount(1)
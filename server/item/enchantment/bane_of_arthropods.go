@@ -0,0 +1,58 @@
+package enchantment
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// BaneOfArthropods is an enchantment applied to a sword or axe that increases melee damage against arthropods, such
+// as spiders, and slows them down.
+type BaneOfArthropods struct{}
+
+// Name ...
+func (BaneOfArthropods) Name() string {
+	return "Bane of Arthropods"
+}
+
+// MaxLevel ...
+func (BaneOfArthropods) MaxLevel() int {
+	return 5
+}
+
+// Cost ...
+func (BaneOfArthropods) Cost(level int) (int, int) {
+	min := 5 + (level-1)*8
+	return min, min + 20
+}
+
+// Rarity ...
+func (BaneOfArthropods) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityUncommon
+}
+
+// Addend returns the additional damage when attacking an arthropod with bane of arthropods.
+func (BaneOfArthropods) Addend(level int) float64 {
+	return float64(level) * 2.5
+}
+
+// SlownessDuration returns a random duration of the slowness applied to an arthropod attacked with bane of
+// arthropods.
+func (BaneOfArthropods) SlownessDuration(level int) time.Duration {
+	return time.Second + time.Duration(rand.Intn(level*10+1))*time.Second/20
+}
+
+// CompatibleWithEnchantment ...
+func (BaneOfArthropods) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, sharpness := t.(Sharpness)
+	_, smite := t.(Smite)
+	return !sharpness && !smite
+}
+
+// CompatibleWithItem ...
+func (BaneOfArthropods) CompatibleWithItem(i world.Item) bool {
+	t, ok := i.(item.Tool)
+	return ok && (t.ToolType() == item.TypeSword || t.ToolType() == item.TypeAxe)
+}
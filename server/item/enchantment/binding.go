@@ -0,0 +1,51 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// CurseOfBinding is an enchantment that prevents the item from being removed from an armour slot, except in
+// creative mode.
+type CurseOfBinding struct{}
+
+// Name ...
+func (CurseOfBinding) Name() string {
+	return "Curse of Binding"
+}
+
+// MaxLevel ...
+func (CurseOfBinding) MaxLevel() int {
+	return 1
+}
+
+// Cost ...
+func (CurseOfBinding) Cost(int) (int, int) {
+	return 25, 50
+}
+
+// Rarity ...
+func (CurseOfBinding) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityVeryRare
+}
+
+// CompatibleWithEnchantment ...
+func (CurseOfBinding) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (CurseOfBinding) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(item.Armour)
+	return ok
+}
+
+// Treasure ...
+func (CurseOfBinding) Treasure() bool {
+	return true
+}
+
+// Curse ...
+func (CurseOfBinding) Curse() bool {
+	return true
+}
@@ -0,0 +1,49 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Channeling is an enchantment applied to a trident that summons lightning on the entity hit by the trident during a
+// thunderstorm.
+type Channeling struct{}
+
+// Name ...
+func (Channeling) Name() string {
+	return "Channeling"
+}
+
+// MaxLevel ...
+func (Channeling) MaxLevel() int {
+	return 1
+}
+
+// Cost ...
+func (Channeling) Cost(int) (int, int) {
+	return 25, 50
+}
+
+// Rarity ...
+func (Channeling) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityVeryRare
+}
+
+// CompatibleWithEnchantment ...
+func (Channeling) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, riptide := t.(Riptide)
+	return !riptide
+}
+
+// CompatibleWithItem ...
+func (Channeling) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(tridentItem)
+	return ok
+}
+
+// tridentItem is implemented by trident items. Only these items may be enchanted with Channeling, Impaling, Loyalty
+// and Riptide.
+type tridentItem interface {
+	world.Item
+	Trident()
+}
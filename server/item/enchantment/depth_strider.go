@@ -30,9 +30,9 @@ func (DepthStrider) Rarity() item.EnchantmentRarity {
 }
 
 // CompatibleWithEnchantment ...
-func (DepthStrider) CompatibleWithEnchantment(item.EnchantmentType) bool {
-	// TODO: Frost Walker
-	return true
+func (DepthStrider) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, frostWalker := t.(FrostWalker)
+	return !frostWalker
 }
 
 // CompatibleWithItem ...
@@ -0,0 +1,56 @@
+package enchantment
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Fortune is an enchantment that increases the amount of items dropped by blocks such as ores when mined.
+type Fortune struct{}
+
+// Name ...
+func (Fortune) Name() string {
+	return "Fortune"
+}
+
+// MaxLevel ...
+func (Fortune) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (Fortune) Cost(level int) (int, int) {
+	min := 15 + (level-1)*9
+	return min, min + 50
+}
+
+// Rarity ...
+func (Fortune) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// DropMultiplier returns a random multiplier for the amount of items dropped by ores mined with Fortune of the level
+// passed. The multiplier is at least 1 and at most level+1.
+func (Fortune) DropMultiplier(level int) int {
+	return max(rand.Intn(level+2)-1, 0) + 1
+}
+
+// DropBonus returns a random amount of additional items dropped by blocks, such as glowstone, that drop a random
+// amount of items when mined with Fortune of the level passed. The bonus is at most the level.
+func (Fortune) DropBonus(level int) int {
+	return rand.Intn(level + 1)
+}
+
+// CompatibleWithEnchantment ...
+func (Fortune) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, silkTouch := t.(SilkTouch)
+	return !silkTouch
+}
+
+// CompatibleWithItem ...
+func (Fortune) CompatibleWithItem(i world.Item) bool {
+	t, ok := i.(item.Tool)
+	return ok && (t.ToolType() != item.TypeSword && t.ToolType() != item.TypeNone)
+}
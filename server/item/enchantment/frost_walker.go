@@ -0,0 +1,52 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// FrostWalker is an enchantment applied to boots that turns water beneath the wearer into frosted ice.
+type FrostWalker struct{}
+
+// Name ...
+func (FrostWalker) Name() string {
+	return "Frost Walker"
+}
+
+// MaxLevel ...
+func (FrostWalker) MaxLevel() int {
+	return 2
+}
+
+// Cost ...
+func (FrostWalker) Cost(level int) (int, int) {
+	min := level * 10
+	return min, min + 15
+}
+
+// Rarity ...
+func (FrostWalker) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// Radius returns the radius around the wearer in which water is turned into frosted ice.
+func (FrostWalker) Radius(level int) int {
+	return min(2+level, 16)
+}
+
+// Treasure ...
+func (FrostWalker) Treasure() bool {
+	return true
+}
+
+// CompatibleWithEnchantment ...
+func (FrostWalker) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, depthStrider := t.(DepthStrider)
+	return !depthStrider
+}
+
+// CompatibleWithItem ...
+func (FrostWalker) CompatibleWithItem(i world.Item) bool {
+	b, ok := i.(item.BootsType)
+	return ok && b.Boots()
+}
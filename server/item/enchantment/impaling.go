@@ -0,0 +1,46 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Impaling is an enchantment applied to a trident that increases the damage dealt to aquatic mobs.
+type Impaling struct{}
+
+// Name ...
+func (Impaling) Name() string {
+	return "Impaling"
+}
+
+// MaxLevel ...
+func (Impaling) MaxLevel() int {
+	return 5
+}
+
+// Cost ...
+func (Impaling) Cost(level int) (int, int) {
+	min := 1 + (level-1)*8
+	return min, min + 20
+}
+
+// Rarity ...
+func (Impaling) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// Addend returns the additional damage when attacking an aquatic mob with impaling.
+func (Impaling) Addend(level int) float64 {
+	return float64(level) * 2.5
+}
+
+// CompatibleWithEnchantment ...
+func (Impaling) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (Impaling) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(tridentItem)
+	return ok
+}
@@ -0,0 +1,55 @@
+package enchantment
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Looting is an enchantment applied to swords that increases the amount of items dropped by mobs killed with it.
+type Looting struct{}
+
+// Name ...
+func (Looting) Name() string {
+	return "Looting"
+}
+
+// MaxLevel ...
+func (Looting) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (Looting) Cost(level int) (int, int) {
+	min := 15 + (level-1)*9
+	return min, min + 50
+}
+
+// Rarity ...
+func (Looting) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// Bonus returns a random amount of additional items of a single type dropped by a mob killed with Looting of the
+// level passed. The bonus is at most the level.
+func (Looting) Bonus(level int) int {
+	return rand.Intn(level + 1)
+}
+
+// ChanceBonus returns the chance added to the chance of a mob dropping a rare item when killed with Looting of the
+// level passed.
+func (Looting) ChanceBonus(level int) float64 {
+	return float64(level) * 0.01
+}
+
+// CompatibleWithEnchantment ...
+func (Looting) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (Looting) CompatibleWithItem(i world.Item) bool {
+	t, ok := i.(item.Tool)
+	return ok && t.ToolType() == item.TypeSword
+}
@@ -0,0 +1,47 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Loyalty is an enchantment applied to a trident that makes the trident return to its thrower after it hits something.
+type Loyalty struct{}
+
+// Name ...
+func (Loyalty) Name() string {
+	return "Loyalty"
+}
+
+// MaxLevel ...
+func (Loyalty) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (Loyalty) Cost(level int) (int, int) {
+	min := 12 + (level-1)*7
+	return min, 50
+}
+
+// Rarity ...
+func (Loyalty) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityUncommon
+}
+
+// ReturnSpeed returns the speed in blocks/tick with which a trident with loyalty returns to its thrower.
+func (Loyalty) ReturnSpeed(level int) float64 {
+	return 0.05 * float64(level)
+}
+
+// CompatibleWithEnchantment ...
+func (Loyalty) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, riptide := t.(Riptide)
+	return !riptide
+}
+
+// CompatibleWithItem ...
+func (Loyalty) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(tridentItem)
+	return ok
+}
@@ -0,0 +1,52 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// LuckOfTheSea is an enchantment to fishing rods that increases the chance of catching treasure and lowers the
+// chance of catching junk.
+type LuckOfTheSea struct{}
+
+// Name ...
+func (LuckOfTheSea) Name() string {
+	return "Luck of the Sea"
+}
+
+// MaxLevel ...
+func (LuckOfTheSea) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (LuckOfTheSea) Cost(level int) (int, int) {
+	min := 15 + (level-1)*9
+	return min, min + 50
+}
+
+// Rarity ...
+func (LuckOfTheSea) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// TreasureChance returns the chance, between 0 and 1, of catching treasure with a fishing rod with the level passed.
+func (LuckOfTheSea) TreasureChance(level int) float64 {
+	return 0.05 + 0.021*float64(level)
+}
+
+// JunkChance returns the chance, between 0 and 1, of catching junk with a fishing rod with the level passed.
+func (LuckOfTheSea) JunkChance(level int) float64 {
+	return max(0.1-0.0195*float64(level), 0)
+}
+
+// CompatibleWithEnchantment ...
+func (LuckOfTheSea) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (LuckOfTheSea) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(item.FishingRod)
+	return ok
+}
@@ -0,0 +1,48 @@
+package enchantment
+
+import (
+	"time"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Lure is an enchantment to fishing rods that decreases the time it takes for a fish to bite the hook.
+type Lure struct{}
+
+// Name ...
+func (Lure) Name() string {
+	return "Lure"
+}
+
+// MaxLevel ...
+func (Lure) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (Lure) Cost(level int) (int, int) {
+	min := 15 + (level-1)*9
+	return min, min + 50
+}
+
+// Rarity ...
+func (Lure) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// WaitTimeReduction returns the time by which the wait time until a fish bites is reduced for the level passed.
+func (Lure) WaitTimeReduction(level int) time.Duration {
+	return time.Duration(level) * time.Second * 5
+}
+
+// CompatibleWithEnchantment ...
+func (Lure) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (Lure) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(item.FishingRod)
+	return ok
+}
@@ -0,0 +1,54 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Multishot is an enchantment applied to a crossbow that makes it shoot three projectiles at once, while only consuming
+// one.
+type Multishot struct{}
+
+// Name ...
+func (Multishot) Name() string {
+	return "Multishot"
+}
+
+// MaxLevel ...
+func (Multishot) MaxLevel() int {
+	return 1
+}
+
+// Cost ...
+func (Multishot) Cost(int) (int, int) {
+	return 20, 50
+}
+
+// Rarity ...
+func (Multishot) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// Projectiles returns the amount of projectiles shot by a crossbow with multishot.
+func (Multishot) Projectiles() int {
+	return 3
+}
+
+// CompatibleWithEnchantment ...
+func (Multishot) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, piercing := t.(Piercing)
+	return !piercing
+}
+
+// CompatibleWithItem ...
+func (Multishot) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(crossbowItem)
+	return ok
+}
+
+// crossbowItem is implemented by crossbow items. Only these items may be enchanted with Multishot, Piercing and Quick
+// Charge.
+type crossbowItem interface {
+	world.Item
+	Crossbow()
+}
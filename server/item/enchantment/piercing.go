@@ -0,0 +1,47 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Piercing is an enchantment applied to a crossbow that makes its arrows pass through entities.
+type Piercing struct{}
+
+// Name ...
+func (Piercing) Name() string {
+	return "Piercing"
+}
+
+// MaxLevel ...
+func (Piercing) MaxLevel() int {
+	return 4
+}
+
+// Cost ...
+func (Piercing) Cost(level int) (int, int) {
+	min := 1 + (level-1)*10
+	return min, 50
+}
+
+// Rarity ...
+func (Piercing) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityCommon
+}
+
+// Entities returns the amount of entities that an arrow shot by a crossbow with piercing passes through.
+func (Piercing) Entities(level int) int {
+	return level
+}
+
+// CompatibleWithEnchantment ...
+func (Piercing) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, multishot := t.(Multishot)
+	return !multishot
+}
+
+// CompatibleWithItem ...
+func (Piercing) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(crossbowItem)
+	return ok
+}
@@ -0,0 +1,48 @@
+package enchantment
+
+import (
+	"time"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// QuickCharge is an enchantment applied to a crossbow that decreases the time it takes to load the crossbow.
+type QuickCharge struct{}
+
+// Name ...
+func (QuickCharge) Name() string {
+	return "Quick Charge"
+}
+
+// MaxLevel ...
+func (QuickCharge) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (QuickCharge) Cost(level int) (int, int) {
+	min := 12 + (level-1)*20
+	return min, 50
+}
+
+// Rarity ...
+func (QuickCharge) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityUncommon
+}
+
+// ChargeDuration returns the duration that loading a crossbow with quick charge takes.
+func (QuickCharge) ChargeDuration(level int) time.Duration {
+	return time.Second*5/4 - time.Second/4*time.Duration(level)
+}
+
+// CompatibleWithEnchantment ...
+func (QuickCharge) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (QuickCharge) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(crossbowItem)
+	return ok
+}
@@ -0,0 +1,49 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Riptide is an enchantment applied to a trident that launches the thrower forward with the trident when thrown while
+// in water or rain, instead of throwing the trident.
+type Riptide struct{}
+
+// Name ...
+func (Riptide) Name() string {
+	return "Riptide"
+}
+
+// MaxLevel ...
+func (Riptide) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (Riptide) Cost(level int) (int, int) {
+	min := 17 + (level-1)*7
+	return min, 50
+}
+
+// Rarity ...
+func (Riptide) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityRare
+}
+
+// Force returns the force with which the thrower is launched forward when throwing a trident with riptide.
+func (Riptide) Force(level int) float64 {
+	return 3 * float64(level+1) / 4
+}
+
+// CompatibleWithEnchantment ...
+func (Riptide) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, loyalty := t.(Loyalty)
+	_, channeling := t.(Channeling)
+	return !loyalty && !channeling
+}
+
+// CompatibleWithItem ...
+func (Riptide) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(tridentItem)
+	return ok
+}
@@ -35,8 +35,10 @@ func (Sharpness) Addend(level int) float64 {
 }
 
 // CompatibleWithEnchantment ...
-func (Sharpness) CompatibleWithEnchantment(item.EnchantmentType) bool {
-	return true
+func (Sharpness) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, smite := t.(Smite)
+	_, bane := t.(BaneOfArthropods)
+	return !smite && !bane
 }
 
 // CompatibleWithItem ...
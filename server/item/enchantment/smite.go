@@ -0,0 +1,49 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Smite is an enchantment applied to a sword or axe that increases melee damage against undead mobs, such as
+// zombies and skeletons.
+type Smite struct{}
+
+// Name ...
+func (Smite) Name() string {
+	return "Smite"
+}
+
+// MaxLevel ...
+func (Smite) MaxLevel() int {
+	return 5
+}
+
+// Cost ...
+func (Smite) Cost(level int) (int, int) {
+	min := 5 + (level-1)*8
+	return min, min + 20
+}
+
+// Rarity ...
+func (Smite) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityUncommon
+}
+
+// Addend returns the additional damage when attacking an undead mob with smite.
+func (Smite) Addend(level int) float64 {
+	return float64(level) * 2.5
+}
+
+// CompatibleWithEnchantment ...
+func (Smite) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, sharpness := t.(Sharpness)
+	_, bane := t.(BaneOfArthropods)
+	return !sharpness && !bane
+}
+
+// CompatibleWithItem ...
+func (Smite) CompatibleWithItem(i world.Item) bool {
+	t, ok := i.(item.Tool)
+	return ok && (t.ToolType() == item.TypeSword || t.ToolType() == item.TypeAxe)
+}
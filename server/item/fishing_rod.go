@@ -0,0 +1,80 @@
+package item
+
+import (
+	"time"
+
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+)
+
+// FishingRod is a tool used to catch fish and other items from water. It may also be used to pull entities
+// towards the user.
+type FishingRod struct{}
+
+// Use casts a fishing hook if the user does not have one cast yet, or reels in the hook if it does.
+func (FishingRod) Use(w *world.World, user User, ctx *UseContext) bool {
+	f, ok := user.(fisher)
+	if !ok {
+		return false
+	}
+	if durability, ok := f.ReelFishingHook(); ok {
+		ctx.DamageItem(durability)
+		return true
+	}
+
+	held, _ := user.HeldItems()
+	lure, luck := 0, 0
+	for _, enchant := range held.Enchantments() {
+		if _, ok := enchant.Type().(interface{ WaitTimeReduction(int) time.Duration }); ok {
+			lure = enchant.Level()
+		}
+		if _, ok := enchant.Type().(interface{ TreasureChance(int) float64 }); ok {
+			luck = enchant.Level()
+		}
+	}
+
+	create := w.EntityRegistry().Config().FishingHook
+	hook := create(eyePosition(user), user.Rotation().Vec3(), user, lure, luck)
+	f.CastFishingHook(hook)
+	w.AddEntity(hook)
+	w.PlaySound(user.Position(), sound.ItemThrow{})
+	return true
+}
+
+// fisher represents a User that is able to fish using a fishing rod.
+type fisher interface {
+	User
+	// CastFishingHook sets the fishing hook passed as the hook currently cast by the fisher.
+	CastFishingHook(hook world.Entity)
+	// ReelFishingHook reels in the fishing hook currently cast by the fisher. The durability that the fishing rod
+	// loses as a result is returned. False is returned if the fisher had no fishing hook cast.
+	ReelFishingHook() (int, bool)
+}
+
+// MaxCount ...
+func (FishingRod) MaxCount() int {
+	return 1
+}
+
+// DurabilityInfo ...
+func (FishingRod) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 385,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// EnchantmentValue ...
+func (FishingRod) EnchantmentValue() int {
+	return 1
+}
+
+// FuelInfo ...
+func (FishingRod) FuelInfo() FuelInfo {
+	return newFuelInfo(time.Second * 15)
+}
+
+// EncodeItem ...
+func (FishingRod) EncodeItem() (name string, meta int16) {
+	return "minecraft:fishing_rod", 0
+}
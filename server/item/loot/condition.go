@@ -0,0 +1,104 @@
+package loot
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Condition is a condition that must be satisfied for a Pool to be rolled or an Entry to be selected.
+type Condition interface {
+	// Satisfied checks if the condition is satisfied in the Context passed.
+	Satisfied(ctx Context) bool
+}
+
+// satisfied checks if all conditions passed are satisfied in the Context passed.
+func satisfied(conditions []Condition, ctx Context) bool {
+	for _, c := range conditions {
+		if !c.Satisfied(ctx) {
+			return false
+		}
+	}
+	return true
+}
+
+// KilledByPlayer is a Condition that is satisfied if the entity dropping the loot was killed by a player.
+type KilledByPlayer struct{}
+
+// Satisfied ...
+func (KilledByPlayer) Satisfied(ctx Context) bool {
+	return ctx.KilledByPlayer
+}
+
+// RandomChance is a Condition that is satisfied with a specific chance.
+type RandomChance struct {
+	// Chance is the chance, between 0 and 1, that the condition is satisfied.
+	Chance float64
+}
+
+// Satisfied ...
+func (c RandomChance) Satisfied(Context) bool {
+	return rand.Float64() < c.Chance
+}
+
+// RandomChanceWithLooting is a Condition that is satisfied with a specific chance, which is increased for every level
+// of Looting in the Context.
+type RandomChanceWithLooting struct {
+	// Chance is the chance, between 0 and 1, that the condition is satisfied without Looting.
+	Chance float64
+	// LootingMultiplier is the chance added for every level of Looting.
+	LootingMultiplier float64
+}
+
+// Satisfied ...
+func (c RandomChanceWithLooting) Satisfied(ctx Context) bool {
+	return rand.Float64() < c.Chance+c.LootingMultiplier*float64(ctx.Looting)
+}
+
+// MatchTool is a Condition that is satisfied if the tool in the Context matches a specific item and has specific
+// enchantments.
+type MatchTool struct {
+	// Item is the item that the tool must be. If nil, any item matches.
+	Item world.Item
+	// Enchantments are the enchantments that the tool must have.
+	Enchantments []EnchantmentMatch
+}
+
+// EnchantmentMatch is an enchantment that a tool must have to satisfy MatchTool, with its level within a range.
+type EnchantmentMatch struct {
+	// Type is the type of the enchantment.
+	Type item.EnchantmentType
+	// Levels is the range that the level of the enchantment must be within. If Levels.Max is 0, the level is not
+	// limited upwards.
+	Levels Range
+}
+
+// Satisfied ...
+func (c MatchTool) Satisfied(ctx Context) bool {
+	if c.Item != nil {
+		if ctx.Tool.Empty() {
+			return false
+		}
+		name, meta := ctx.Tool.Item().EncodeItem()
+		if wantName, wantMeta := c.Item.EncodeItem(); name != wantName || meta != wantMeta {
+			return false
+		}
+	}
+	for _, m := range c.Enchantments {
+		e, ok := ctx.Tool.Enchantment(m.Type)
+		if !ok || float64(e.Level()) < m.Levels.Min || (m.Levels.Max > 0 && float64(e.Level()) > m.Levels.Max) {
+			return false
+		}
+	}
+	return true
+}
+
+// never is a Condition that is never satisfied. It is used for conditions found in loot tables that are not
+// supported, so that loot depending on them is never generated.
+type never struct{}
+
+// Satisfied ...
+func (never) Satisfied(Context) bool {
+	return false
+}
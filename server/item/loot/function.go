@@ -0,0 +1,219 @@
+package loot
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Function is a function applied to the item produced by an Entry, modifying it.
+type Function interface {
+	// Apply applies the function to the item stack passed and returns the resulting item stack.
+	Apply(s item.Stack, ctx Context) item.Stack
+}
+
+// SetCount is a Function that sets the count of the item stack to a random number within a range.
+type SetCount struct {
+	// Count is the range of the count of the item stack.
+	Count Range
+}
+
+// Apply ...
+func (f SetCount) Apply(s item.Stack, _ Context) item.Stack {
+	return s.Grow(f.Count.Int() - s.Count())
+}
+
+// SetData is a Function that sets the metadata value of the item in the item stack, changing it to a variant of the
+// item.
+type SetData struct {
+	// Data is the metadata value of the item.
+	Data int16
+}
+
+// Apply ...
+func (f SetData) Apply(s item.Stack, _ Context) item.Stack {
+	if s.Empty() {
+		return s
+	}
+	name, _ := s.Item().EncodeItem()
+	if it, ok := world.ItemByName(name, f.Data); ok {
+		return s.WithItem(it)
+	}
+	return s
+}
+
+// SetDamage is a Function that sets the durability of the item stack to a random fraction of its maximum durability.
+type SetDamage struct {
+	// Damage is the range of the fraction, between 0 and 1, of durability left.
+	Damage Range
+}
+
+// Apply ...
+func (f SetDamage) Apply(s item.Stack, _ Context) item.Stack {
+	if s.Empty() || s.MaxDurability() == -1 {
+		return s
+	}
+	return s.WithDurability(max(int(float64(s.MaxDurability())*f.Damage.Float()), 1))
+}
+
+// SetName is a Function that sets the custom name of the item stack.
+type SetName struct {
+	// Name is the custom name of the item stack.
+	Name string
+}
+
+// Apply ...
+func (f SetName) Apply(s item.Stack, _ Context) item.Stack {
+	return s.WithCustomName(f.Name)
+}
+
+// SetLore is a Function that sets the lore of the item stack.
+type SetLore struct {
+	// Lore holds the lines of the lore of the item stack.
+	Lore []string
+}
+
+// Apply ...
+func (f SetLore) Apply(s item.Stack, _ Context) item.Stack {
+	return s.WithLore(f.Lore...)
+}
+
+// SpecificEnchants is a Function that adds specific enchantments to the item stack.
+type SpecificEnchants struct {
+	// Enchantments are the enchantments added to the item stack.
+	Enchantments []item.Enchantment
+}
+
+// Apply ...
+func (f SpecificEnchants) Apply(s item.Stack, _ Context) item.Stack {
+	return enchant(s, f.Enchantments...)
+}
+
+// EnchantRandomly is a Function that adds a random enchantment with a random level that is compatible with the item
+// to the item stack.
+type EnchantRandomly struct {
+	// Treasure specifies if treasure enchantments, such as Mending, may be selected.
+	Treasure bool
+}
+
+// Apply ...
+func (f EnchantRandomly) Apply(s item.Stack, _ Context) item.Stack {
+	compatible := compatibleEnchantments(s, f.Treasure)
+	if len(compatible) == 0 {
+		return s
+	}
+	t := compatible[rand.Intn(len(compatible))]
+	return enchant(s, item.NewEnchantment(t, rand.Intn(t.MaxLevel())+1))
+}
+
+// EnchantWithLevels is a Function that enchants the item stack as if it was enchanted in an enchanting table using a
+// specific amount of levels.
+type EnchantWithLevels struct {
+	// Levels is the range of the amount of levels used to enchant the item stack.
+	Levels Range
+	// Treasure specifies if treasure enchantments, such as Mending, may be selected.
+	Treasure bool
+}
+
+// Apply ...
+func (f EnchantWithLevels) Apply(s item.Stack, _ Context) item.Stack {
+	cost := f.Levels.Int()
+	if e, ok := s.Item().(item.Enchantable); ok {
+		value := e.EnchantmentValue()
+		cost += 1 + rand.Intn(value/4+1) + rand.Intn(value/4+1)
+	}
+
+	var available []item.Enchantment
+	for _, t := range compatibleEnchantments(s, f.Treasure) {
+		for lvl := t.MaxLevel(); lvl > 0; lvl-- {
+			if minCost, maxCost := t.Cost(lvl); cost >= minCost && cost <= maxCost {
+				available = append(available, item.NewEnchantment(t, lvl))
+				break
+			}
+		}
+	}
+	var selected []item.Enchantment
+	for len(available) > 0 {
+		i := rand.Intn(len(available))
+		e := available[i]
+		selected = append(selected, e)
+
+		compatible := available[:0]
+		for j, other := range available {
+			if j != i && e.Type().CompatibleWithEnchantment(other.Type()) {
+				compatible = append(compatible, other)
+			}
+		}
+		available = compatible
+		if rand.Intn(50) > cost {
+			break
+		}
+		cost /= 2
+	}
+	return enchant(s, selected...)
+}
+
+// LootingEnchant is a Function that increases the count of the item stack for every level of Looting in the Context.
+type LootingEnchant struct {
+	// Count is the range of the count added for every level of Looting.
+	Count Range
+}
+
+// Apply ...
+func (f LootingEnchant) Apply(s item.Stack, ctx Context) item.Stack {
+	n := 0
+	for i := 0; i < ctx.Looting; i++ {
+		n += f.Count.Int()
+	}
+	return s.Grow(n)
+}
+
+// FurnaceSmelt is a Function that smelts the item in the item stack, changing it to the product of smelting it in a
+// furnace, such as cooked meat for raw meat.
+type FurnaceSmelt struct{}
+
+// Apply ...
+func (FurnaceSmelt) Apply(s item.Stack, _ Context) item.Stack {
+	if sm, ok := s.Item().(item.Smeltable); ok && !sm.SmeltInfo().Product.Empty() {
+		return s.WithItem(sm.SmeltInfo().Product.Item())
+	}
+	return s
+}
+
+// treasureEnchantment represents an enchantment that may be a treasure enchantment.
+type treasureEnchantment interface {
+	item.EnchantmentType
+	Treasure() bool
+}
+
+// compatibleEnchantments returns all enchantment types compatible with the item stack passed. Treasure enchantments are
+// only included if treasure is true. Books are compatible with every enchantment.
+func compatibleEnchantments(s item.Stack, treasure bool) []item.EnchantmentType {
+	if s.Empty() {
+		return nil
+	}
+	_, book := s.Item().(item.Book)
+	var compatible []item.EnchantmentType
+	for _, t := range item.Enchantments() {
+		if tr, ok := t.(treasureEnchantment); ok && tr.Treasure() && !treasure {
+			continue
+		}
+		if book || t.CompatibleWithItem(s.Item()) {
+			compatible = append(compatible, t)
+		}
+	}
+	return compatible
+}
+
+// enchant adds the enchantments passed to the item stack. Books are turned into enchanted books if any enchantments
+// are added.
+func enchant(s item.Stack, enchantments ...item.Enchantment) item.Stack {
+	if len(enchantments) == 0 {
+		return s
+	}
+	if _, ok := s.Item().(item.Book); ok {
+		s = s.WithItem(item.EnchantedBook{})
+	}
+	return s.WithEnchantments(enchantments...)
+}
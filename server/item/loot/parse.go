@@ -0,0 +1,266 @@
+package loot
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Parse parses a Table from JSON data in the format of the loot tables found in behaviour packs. Conditions that are
+// not supported are never satisfied, and functions that are not supported are ignored. Entries holding items that are
+// not registered produce nothing.
+func Parse(b []byte) (Table, error) {
+	var data struct {
+		Pools []struct {
+			Rolls      Range             `json:"rolls"`
+			Conditions []json.RawMessage `json:"conditions"`
+			Entries    []struct {
+				Type       string            `json:"type"`
+				Name       string            `json:"name"`
+				Weight     *int              `json:"weight"`
+				Conditions []json.RawMessage `json:"conditions"`
+				Functions  []json.RawMessage `json:"functions"`
+			} `json:"entries"`
+		} `json:"pools"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return Table{}, fmt.Errorf("parse loot table: %w", err)
+	}
+	var t Table
+	for _, pd := range data.Pools {
+		p := Pool{Rolls: pd.Rolls}
+		conditions, err := parseConditions(pd.Conditions)
+		if err != nil {
+			return Table{}, err
+		}
+		p.Conditions = conditions
+
+		for _, ed := range pd.Entries {
+			e := Entry{Weight: 1}
+			if ed.Weight != nil {
+				e.Weight = *ed.Weight
+			}
+			switch ed.Type {
+			case "item":
+				e.Item, _ = world.ItemByName(itemName(ed.Name), 0)
+			case "loot_table":
+				e.Table = ed.Name
+			case "empty":
+			default:
+				return Table{}, fmt.Errorf("parse loot table: unknown entry type %q", ed.Type)
+			}
+			if e.Conditions, err = parseConditions(ed.Conditions); err != nil {
+				return Table{}, err
+			}
+			if e.Functions, err = parseFunctions(ed.Functions); err != nil {
+				return Table{}, err
+			}
+			p.Entries = append(p.Entries, e)
+		}
+		t.Pools = append(t.Pools, p)
+	}
+	return t, nil
+}
+
+// parseConditions parses a list of conditions from their JSON data.
+func parseConditions(data []json.RawMessage) ([]Condition, error) {
+	conditions := make([]Condition, 0, len(data))
+	for _, d := range data {
+		var c struct {
+			Condition         string            `json:"condition"`
+			Chance            float64           `json:"chance"`
+			LootingMultiplier float64           `json:"looting_multiplier"`
+			Item              string            `json:"item"`
+			Enchantments      []enchantmentData `json:"enchantments"`
+		}
+		if err := json.Unmarshal(d, &c); err != nil {
+			return nil, fmt.Errorf("parse loot table condition: %w", err)
+		}
+		switch c.Condition {
+		case "killed_by_player", "killed_by_player_or_pets":
+			conditions = append(conditions, KilledByPlayer{})
+		case "random_chance":
+			conditions = append(conditions, RandomChance{Chance: c.Chance})
+		case "random_chance_with_looting":
+			conditions = append(conditions, RandomChanceWithLooting{Chance: c.Chance, LootingMultiplier: c.LootingMultiplier})
+		case "match_tool":
+			conditions = append(conditions, parseMatchTool(c.Item, c.Enchantments))
+		default:
+			conditions = append(conditions, never{})
+		}
+	}
+	return conditions, nil
+}
+
+// enchantmentData is the JSON data of an enchantment required by a match_tool condition.
+type enchantmentData struct {
+	Enchantment string `json:"enchantment"`
+	Levels      Range  `json:"levels"`
+}
+
+// parseMatchTool parses a match_tool condition from the item name and enchantments passed. If the item or any of the
+// enchantments is not registered, a condition that is never satisfied is returned, as no tool could match it.
+func parseMatchTool(name string, enchantments []enchantmentData) Condition {
+	m := MatchTool{}
+	if name != "" {
+		it, ok := world.ItemByName(itemName(name), 0)
+		if !ok {
+			return never{}
+		}
+		m.Item = it
+	}
+	for _, e := range enchantments {
+		t, ok := enchantmentByName(e.Enchantment)
+		if !ok {
+			return never{}
+		}
+		m.Enchantments = append(m.Enchantments, EnchantmentMatch{Type: t, Levels: e.Levels})
+	}
+	return m
+}
+
+// parseFunctions parses a list of functions from their JSON data.
+func parseFunctions(data []json.RawMessage) ([]Function, error) {
+	functions := make([]Function, 0, len(data))
+	for _, d := range data {
+		var f struct {
+			Function string          `json:"function"`
+			Count    Range           `json:"count"`
+			Data     int16           `json:"data"`
+			Damage   Range           `json:"damage"`
+			Name     string          `json:"name"`
+			Lore     []string        `json:"lore"`
+			Levels   Range           `json:"levels"`
+			Treasure bool            `json:"treasure"`
+			Enchants json.RawMessage `json:"enchants"`
+		}
+		if err := json.Unmarshal(d, &f); err != nil {
+			return nil, fmt.Errorf("parse loot table function: %w", err)
+		}
+		switch f.Function {
+		case "set_count":
+			functions = append(functions, SetCount{Count: f.Count})
+		case "set_data":
+			functions = append(functions, SetData{Data: f.Data})
+		case "set_damage":
+			functions = append(functions, SetDamage{Damage: f.Damage})
+		case "set_name":
+			functions = append(functions, SetName{Name: f.Name})
+		case "set_lore":
+			functions = append(functions, SetLore{Lore: f.Lore})
+		case "enchant_randomly", "enchant_random_gear":
+			functions = append(functions, EnchantRandomly{Treasure: f.Treasure})
+		case "enchant_with_levels":
+			functions = append(functions, EnchantWithLevels{Levels: f.Levels, Treasure: f.Treasure})
+		case "looting_enchant":
+			functions = append(functions, LootingEnchant{Count: f.Count})
+		case "furnace_smelt":
+			functions = append(functions, FurnaceSmelt{})
+		case "specific_enchants":
+			enchantments, err := parseEnchants(f.Enchants)
+			if err != nil {
+				return nil, err
+			}
+			functions = append(functions, SpecificEnchants{Enchantments: enchantments})
+		}
+	}
+	return functions, nil
+}
+
+// parseEnchants parses the enchantments of a specific_enchants function. Each enchantment is either the name of the
+// enchantment or an object holding its name and level.
+func parseEnchants(data json.RawMessage) ([]item.Enchantment, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse loot table enchants: %w", err)
+	}
+	enchantments := make([]item.Enchantment, 0, len(raw))
+	for _, r := range raw {
+		e := struct {
+			ID    string `json:"id"`
+			Level Range  `json:"level"`
+		}{Level: Range{Min: 1, Max: 1}}
+		if err := json.Unmarshal(r, &e.ID); err != nil {
+			if err := json.Unmarshal(r, &e); err != nil {
+				return nil, fmt.Errorf("parse loot table enchants: %w", err)
+			}
+		}
+		t, ok := enchantmentByName(e.ID)
+		if !ok {
+			// The enchantment is not implemented, so it cannot be added.
+			continue
+		}
+		enchantments = append(enchantments, item.NewEnchantment(t, min(max(e.Level.Int(), 1), t.MaxLevel())))
+	}
+	return enchantments, nil
+}
+
+// UnmarshalJSON decodes a Range from either a single number or an object with a min and max field. The range_min and
+// range_max fields used by some conditions are accepted too.
+func (r *Range) UnmarshalJSON(b []byte) error {
+	var n float64
+	if err := json.Unmarshal(b, &n); err == nil {
+		*r = Range{Min: n, Max: n}
+		return nil
+	}
+	var m struct {
+		Min      *float64 `json:"min"`
+		Max      *float64 `json:"max"`
+		RangeMin *float64 `json:"range_min"`
+		RangeMax *float64 `json:"range_max"`
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("decode range: %w", err)
+	}
+	*r = Range{}
+	if v := firstNonNil(m.Min, m.RangeMin); v != nil {
+		r.Min = *v
+	}
+	if v := firstNonNil(m.Max, m.RangeMax); v != nil {
+		r.Max = *v
+	} else if m.Min != nil {
+		r.Max = r.Min
+	}
+	return nil
+}
+
+// firstNonNil returns the first of the values passed that is not nil.
+func firstNonNil(values ...*float64) *float64 {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// itemName returns the name passed with the minecraft: namespace prefixed if it has no namespace.
+func itemName(name string) string {
+	if !strings.Contains(name, ":") {
+		return "minecraft:" + name
+	}
+	return name
+}
+
+// enchantmentNames holds the names of enchantments as used in loot tables, indexed by their IDs.
+var enchantmentNames = [...]string{
+	"protection", "fire_protection", "feather_falling", "blast_protection", "projectile_protection", "thorns",
+	"respiration", "depth_strider", "aqua_affinity", "sharpness", "smite", "bane_of_arthropods", "knockback",
+	"fire_aspect", "looting", "efficiency", "silk_touch", "unbreaking", "fortune", "power", "punch", "flame",
+	"infinity", "luck_of_the_sea", "lure", "frost_walker", "mending", "binding", "vanishing", "impaling", "riptide",
+	"loyalty", "channeling", "multishot", "piercing", "quick_charge", "soul_speed", "swift_sneak",
+}
+
+// enchantmentByName looks up a registered enchantment by the name used for it in loot tables.
+func enchantmentByName(name string) (item.EnchantmentType, bool) {
+	name = strings.TrimPrefix(name, "minecraft:")
+	for id, n := range enchantmentNames {
+		if n == name {
+			return item.EnchantmentByID(id)
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,73 @@
+package loot
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+)
+
+var (
+	tablesMu sync.RWMutex
+	// tables holds all registered loot tables, indexed by their names.
+	tables = map[string]Table{}
+)
+
+// Register registers a loot table under the name passed, such as 'loot_tables/chests/simple_dungeon.json'. If a
+// loot table was already registered under the name, it is replaced.
+func Register(name string, t Table) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	tables[name] = t
+}
+
+// Lookup looks up the loot table registered under the name passed. If found, the loot table is returned and the bool
+// is true.
+func Lookup(name string) (Table, bool) {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	t, ok := tables[name]
+	return t, ok
+}
+
+// LoadPack parses and registers all loot tables found in the loot_tables directory of the behaviour pack file system
+// passed, such as one obtained using os.DirFS. Each loot table is registered under its path in the pack, for example
+// 'loot_tables/entities/zombie.json'.
+func LoadPack(pack fs.FS) error {
+	return fs.WalkDir(pack, "loot_tables", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(p) != ".json" {
+			return err
+		}
+		b, err := fs.ReadFile(pack, p)
+		if err != nil {
+			return fmt.Errorf("load loot table %v: %w", p, err)
+		}
+		t, err := Parse(b)
+		if err != nil {
+			return fmt.Errorf("load loot table %v: %w", p, err)
+		}
+		Register(p, t)
+		return nil
+	})
+}
+
+// BlockTable returns the name of the loot table used for the drops of a block with the name passed, such as
+// 'minecraft:stone'.
+func BlockTable(name string) string {
+	return "loot_tables/blocks/" + strings.TrimPrefix(name, "minecraft:") + ".json"
+}
+
+// EntityTable returns the name of the loot table used for the drops of an entity with the name passed, such as
+// 'minecraft:zombie'.
+func EntityTable(name string) string {
+	return "loot_tables/entities/" + strings.TrimPrefix(name, "minecraft:") + ".json"
+}
+
+// Names of the loot tables used for the items caught while fishing. One of these loot tables is selected for every
+// catch, with the chance of catching treasure or junk depending on the Luck of the Sea level of the fishing rod.
+const (
+	FishingFish     = "loot_tables/gameplay/fishing/fish.json"
+	FishingTreasure = "loot_tables/gameplay/fishing/treasure.json"
+	FishingJunk     = "loot_tables/gameplay/fishing/junk.json"
+)
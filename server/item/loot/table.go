@@ -0,0 +1,189 @@
+// Package loot implements loot tables in the format used by behaviour packs. Loot tables generate items for block
+// drops, entity drops and containers, and may be registered to customise these without changing the implementation
+// of blocks and entities.
+package loot
+
+import (
+	"math/rand"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Table is a loot table. It holds pools that are each rolled to generate the items of the loot table.
+type Table struct {
+	// Pools are the pools of the loot table. Each pool is rolled separately when generating loot.
+	Pools []Pool
+}
+
+// Pool is a pool of entries in a Table. When rolled, a weighted random entry is selected from the pool.
+type Pool struct {
+	// Rolls is the range of the amount of times that the pool is rolled.
+	Rolls Range
+	// Conditions are the conditions that must all be satisfied for the pool to be rolled at all.
+	Conditions []Condition
+	// Entries are the entries of the pool, of which one is selected on every roll.
+	Entries []Entry
+}
+
+// Entry is an entry in a Pool. An entry either produces an item, the loot of another loot table or nothing at all.
+type Entry struct {
+	// Item is the item produced by the entry. If nil, the entry produces the loot of the loot table with the name
+	// Table, or nothing if Table is empty.
+	Item world.Item
+	// Table is the name of the loot table whose loot is produced by the entry if Item is nil.
+	Table string
+	// Weight is the weight of the entry. Entries with a higher weight are more likely to be selected.
+	Weight int
+	// Conditions are the conditions that must all be satisfied for the entry to be selected.
+	Conditions []Condition
+	// Functions are the functions applied to the item produced by the entry.
+	Functions []Function
+}
+
+// Context holds the context in which loot is generated. It is passed to conditions and functions to decide on the
+// loot generated.
+type Context struct {
+	// Tool is the item used to obtain the loot, such as the tool used to break a block.
+	Tool item.Stack
+	// Looting is the level of the Looting enchantment of the weapon used to kill an entity.
+	Looting int
+	// KilledByPlayer specifies if the entity that drops the loot was killed by a player.
+	KilledByPlayer bool
+}
+
+// Generate generates the loot of the Table using the Context passed. Stacks exceeding their maximum count are split up
+// into multiple stacks.
+func (t Table) Generate(ctx Context) []item.Stack {
+	return t.generate(ctx, 0)
+}
+
+// maxTableDepth is the maximum depth of loot tables referring to other loot tables. It prevents loot tables that refer
+// to each other from recursing endlessly.
+const maxTableDepth = 16
+
+// generate generates the loot of the Table, with depth being the amount of loot tables that referred to this table.
+func (t Table) generate(ctx Context, depth int) []item.Stack {
+	var stacks []item.Stack
+	for _, p := range t.Pools {
+		if !satisfied(p.Conditions, ctx) {
+			continue
+		}
+		for i := p.Rolls.Int(); i > 0; i-- {
+			e, ok := p.roll(ctx)
+			if !ok {
+				continue
+			}
+			stacks = append(stacks, e.generate(ctx, depth)...)
+		}
+	}
+	return stacks
+}
+
+// roll selects a weighted random entry from the Pool whose conditions are satisfied. False is returned if no such entry
+// exists.
+func (p Pool) roll(ctx Context) (Entry, bool) {
+	entries, total := make([]Entry, 0, len(p.Entries)), 0
+	for _, e := range p.Entries {
+		if e.Weight > 0 && satisfied(e.Conditions, ctx) {
+			entries, total = append(entries, e), total+e.Weight
+		}
+	}
+	if total == 0 {
+		return Entry{}, false
+	}
+	n := rand.Intn(total)
+	for _, e := range entries {
+		if n -= e.Weight; n < 0 {
+			return e, true
+		}
+	}
+	panic("should never happen")
+}
+
+// generate generates the loot produced by the Entry.
+func (e Entry) generate(ctx Context, depth int) []item.Stack {
+	if e.Item == nil {
+		if e.Table == "" || depth >= maxTableDepth {
+			return nil
+		}
+		t, ok := Lookup(e.Table)
+		if !ok {
+			return nil
+		}
+		return t.generate(ctx, depth+1)
+	}
+	s := item.NewStack(e.Item, 1)
+	for _, f := range e.Functions {
+		s = f.Apply(s, ctx)
+	}
+	var stacks []item.Stack
+	for s.Count() > 0 {
+		n := min(s.Count(), s.MaxCount())
+		stacks = append(stacks, s.Grow(n-s.Count()))
+		s = s.Grow(-n)
+	}
+	return stacks
+}
+
+// Fill fills the inventory passed with loot generated by the Table using the Context passed. The loot is spread over
+// random empty slots of the inventory. Stacks are split up to fill more slots if enough empty slots are available. Loot
+// that does not fit in the inventory is discarded.
+func (t Table) Fill(inv *inventory.Inventory, ctx Context) {
+	var free []int
+	for slot, it := range inv.Slots() {
+		if it.Empty() {
+			free = append(free, slot)
+		}
+	}
+	rand.Shuffle(len(free), func(i, j int) {
+		free[i], free[j] = free[j], free[i]
+	})
+
+	stacks := t.Generate(ctx)
+	for len(stacks) < len(free) {
+		var splittable []int
+		for i, s := range stacks {
+			if s.Count() > 1 {
+				splittable = append(splittable, i)
+			}
+		}
+		if len(splittable) == 0 {
+			break
+		}
+		i := splittable[rand.Intn(len(splittable))]
+		n := 1 + rand.Intn(stacks[i].Count()/2)
+		stacks = append(stacks, stacks[i].Grow(n-stacks[i].Count()))
+		stacks[i] = stacks[i].Grow(-n)
+	}
+	for i, s := range stacks {
+		if i >= len(free) {
+			break
+		}
+		_ = inv.SetItem(free[i], s)
+	}
+}
+
+// Range is a range of numbers, from Min to Max inclusive. In JSON, it is either a single number or an object with a
+// min and max field.
+type Range struct {
+	Min, Max float64
+}
+
+// Int returns a random integer within the Range.
+func (r Range) Int() int {
+	lo, hi := int(r.Min), int(r.Max)
+	if hi <= lo {
+		return lo
+	}
+	return lo + rand.Intn(hi-lo+1)
+}
+
+// Float returns a random float64 within the Range.
+func (r Range) Float() float64 {
+	if r.Max <= r.Min {
+		return r.Min
+	}
+	return r.Min + rand.Float64()*(r.Max-r.Min)
+}
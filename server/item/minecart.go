@@ -0,0 +1,100 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Minecart is an item that may be placed on rails to create a minecart that players are able to ride in.
+type Minecart struct{}
+
+// MaxCount always returns 1.
+func (Minecart) MaxCount() int {
+	return 1
+}
+
+// UseOnBlock places a minecart on the rail clicked.
+func (Minecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().Minecart)
+}
+
+// EncodeItem ...
+func (Minecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:minecart", 0
+}
+
+// ChestMinecart is an item that may be placed on rails to create a minecart carrying a chest, which is able to hold
+// 27 stacks of items.
+type ChestMinecart struct{}
+
+// MaxCount always returns 1.
+func (ChestMinecart) MaxCount() int {
+	return 1
+}
+
+// UseOnBlock places a chest minecart on the rail clicked.
+func (ChestMinecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().ChestMinecart)
+}
+
+// EncodeItem ...
+func (ChestMinecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:chest_minecart", 0
+}
+
+// HopperMinecart is an item that may be placed on rails to create a minecart carrying a hopper, which collects items
+// from containers above it and items lying on the ground.
+type HopperMinecart struct{}
+
+// MaxCount always returns 1.
+func (HopperMinecart) MaxCount() int {
+	return 1
+}
+
+// UseOnBlock places a hopper minecart on the rail clicked.
+func (HopperMinecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().HopperMinecart)
+}
+
+// EncodeItem ...
+func (HopperMinecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:hopper_minecart", 0
+}
+
+// TNTMinecart is an item that may be placed on rails to create a minecart carrying TNT, which explodes when it is
+// activated.
+type TNTMinecart struct{}
+
+// MaxCount always returns 1.
+func (TNTMinecart) MaxCount() int {
+	return 1
+}
+
+// UseOnBlock places a TNT minecart on the rail clicked.
+func (TNTMinecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().TNTMinecart)
+}
+
+// EncodeItem ...
+func (TNTMinecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:tnt_minecart", 0
+}
+
+// placeMinecart places a minecart created using the function passed on the rail at the position passed. If the
+// block at that position is not a rail, placeMinecart returns false.
+func placeMinecart(pos cube.Pos, w *world.World, ctx *UseContext, create func(pos mgl64.Vec3) world.Entity) bool {
+	if _, ok := w.Block(pos).(MinecartRail); !ok {
+		return false
+	}
+	w.AddEntity(create(pos.Vec3Middle()))
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// MinecartRail represents a block that minecarts are able to be placed on and ride on, such as a rail.
+type MinecartRail interface {
+	world.Block
+	// MinecartRail is implemented by all rails. It does nothing.
+	MinecartRail()
+}
@@ -0,0 +1,205 @@
+package recipe
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Brew returns the item.Stack that the potion passed turns into when it is brewed with the reagent passed on the
+// block passed, such as "brewing_stand". False is returned if no Potion or PotionContainerChange recipe
+// exists for the combination.
+func Brew(input, reagent item.Stack, block string) (item.Stack, bool) {
+	t, ok := potionType(input.Item())
+	if !ok || reagent.Empty() {
+		return item.Stack{}, false
+	}
+	for _, r := range brewing {
+		in := r.Input()
+		if r.Block() != block || !matchesItem(in[1], reagent) {
+			continue
+		}
+		switch r.(type) {
+		case Potion:
+			if want, _ := potionType(stackItem(in[0])); want != t {
+				continue
+			}
+			out, _ := potionType(r.Output()[0].Item())
+			return item.NewStack(withPotionType(input.Item(), out), 1), true
+		case PotionContainerChange:
+			if !sameName(stackItem(in[0]), input.Item()) {
+				continue
+			}
+			return item.NewStack(withPotionType(r.Output()[0].Item(), t), 1), true
+		}
+	}
+	return item.Stack{}, false
+}
+
+// BrewingReagent checks if the item.Stack passed is used as the reagent of any Potion or PotionContainerChange
+// recipe brewed on the block passed.
+func BrewingReagent(reagent item.Stack, block string) bool {
+	for _, r := range brewing {
+		if r.Block() == block && matchesItem(r.Input()[1], reagent) {
+			return true
+		}
+	}
+	return false
+}
+
+// brewingRecipes implements block.BrewingRecipes using the Potion and PotionContainerChange recipes registered.
+type brewingRecipes struct{}
+
+// Brew ...
+func (brewingRecipes) Brew(input, reagent item.Stack, block string) (item.Stack, bool) {
+	return Brew(input, reagent, block)
+}
+
+// BrewingReagent ...
+func (brewingRecipes) BrewingReagent(reagent item.Stack, block string) bool {
+	return BrewingReagent(reagent, block)
+}
+
+// matchesItem checks if the recipe Item passed matches the item.Stack passed. Items match if they have the same name
+// and meta, or if the Item is an ItemTag containing the item.
+func matchesItem(i Item, s item.Stack) bool {
+	if s.Empty() {
+		return false
+	}
+	name, meta := s.Item().EncodeItem()
+	switch i := i.(type) {
+	case item.Stack:
+		if i.Empty() {
+			return false
+		}
+		otherName, otherMeta := i.Item().EncodeItem()
+		return name == otherName && meta == otherMeta
+	case ItemTag:
+		return i.Contains(name)
+	}
+	return false
+}
+
+// stackItem returns the world.Item held by a recipe Item if it is an item.Stack, or nil otherwise.
+func stackItem(i Item) world.Item {
+	if s, ok := i.(item.Stack); ok {
+		return s.Item()
+	}
+	return nil
+}
+
+// sameName checks if the two items passed have the same name, disregarding their meta.
+func sameName(a, b world.Item) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	nameA, _ := a.EncodeItem()
+	nameB, _ := b.EncodeItem()
+	return nameA == nameB
+}
+
+// potionType returns the potion.Potion held by the potion, splash potion or lingering potion passed.
+func potionType(it world.Item) (potion.Potion, bool) {
+	switch it := it.(type) {
+	case item.Potion:
+		return it.Type, true
+	case item.SplashPotion:
+		return it.Type, true
+	case item.LingeringPotion:
+		return it.Type, true
+	}
+	return potion.Potion{}, false
+}
+
+// withPotionType returns the potion, splash potion or lingering potion passed with its type changed to t.
+func withPotionType(it world.Item, t potion.Potion) world.Item {
+	switch it.(type) {
+	case item.Potion:
+		return item.Potion{Type: t}
+	case item.SplashPotion:
+		return item.SplashPotion{Type: t}
+	case item.LingeringPotion:
+		return item.LingeringPotion{Type: t}
+	}
+	return it
+}
+
+// registerVanillaBrewing registers the Potion and PotionContainerChange recipes of vanilla brewing stands.
+func registerVanillaBrewing() {
+	const stand = "brewing_stand"
+	mix := func(input potion.Potion, reagent world.Item, output potion.Potion) {
+		Register(NewPotion(item.NewStack(item.Potion{Type: input}, 1), item.NewStack(reagent, 1), item.NewStack(item.Potion{Type: output}, 1), stand))
+	}
+
+	mix(potion.Water(), block.NetherWart{}, potion.Awkward())
+	mix(potion.Water(), item.RedstoneDust{}, potion.LongMundane())
+	mix(potion.Water(), item.GlowstoneDust{}, potion.Thick())
+	mix(potion.Water(), item.FermentedSpiderEye{}, potion.Weakness())
+	for _, reagent := range []world.Item{
+		item.Sugar{}, item.RabbitFoot{}, item.GlisteringMelonSlice{}, item.SpiderEye{}, item.MagmaCream{},
+		item.BlazePowder{}, item.GhastTear{},
+	} {
+		mix(potion.Water(), reagent, potion.Mundane())
+	}
+
+	mix(potion.Awkward(), item.GoldenCarrot{}, potion.NightVision())
+	mix(potion.Awkward(), item.MagmaCream{}, potion.FireResistance())
+	mix(potion.Awkward(), item.RabbitFoot{}, potion.Leaping())
+	mix(potion.Awkward(), item.Sugar{}, potion.Swiftness())
+	mix(potion.Awkward(), item.Pufferfish{}, potion.WaterBreathing())
+	mix(potion.Awkward(), item.GlisteringMelonSlice{}, potion.Healing())
+	mix(potion.Awkward(), item.SpiderEye{}, potion.Poison())
+	mix(potion.Awkward(), item.GhastTear{}, potion.Regeneration())
+	mix(potion.Awkward(), item.BlazePowder{}, potion.Strength())
+	mix(potion.Awkward(), item.TurtleShell{}, potion.TurtleMaster())
+	mix(potion.Awkward(), item.PhantomMembrane{}, potion.SlowFalling())
+
+	// Fermented spider eyes corrupt potions into their negative counterparts.
+	mix(potion.NightVision(), item.FermentedSpiderEye{}, potion.Invisibility())
+	mix(potion.LongNightVision(), item.FermentedSpiderEye{}, potion.LongInvisibility())
+	mix(potion.Swiftness(), item.FermentedSpiderEye{}, potion.Slowness())
+	mix(potion.LongSwiftness(), item.FermentedSpiderEye{}, potion.LongSlowness())
+	mix(potion.Leaping(), item.FermentedSpiderEye{}, potion.Slowness())
+	mix(potion.LongLeaping(), item.FermentedSpiderEye{}, potion.LongSlowness())
+	mix(potion.Healing(), item.FermentedSpiderEye{}, potion.Harming())
+	mix(potion.StrongHealing(), item.FermentedSpiderEye{}, potion.StrongHarming())
+	mix(potion.Poison(), item.FermentedSpiderEye{}, potion.Harming())
+	mix(potion.LongPoison(), item.FermentedSpiderEye{}, potion.Harming())
+	mix(potion.StrongPoison(), item.FermentedSpiderEye{}, potion.StrongHarming())
+
+	// Redstone extends the duration of potions, while glowstone increases their strength.
+	for _, p := range [][2]potion.Potion{
+		{potion.NightVision(), potion.LongNightVision()},
+		{potion.Invisibility(), potion.LongInvisibility()},
+		{potion.Leaping(), potion.LongLeaping()},
+		{potion.FireResistance(), potion.LongFireResistance()},
+		{potion.Swiftness(), potion.LongSwiftness()},
+		{potion.Slowness(), potion.LongSlowness()},
+		{potion.WaterBreathing(), potion.LongWaterBreathing()},
+		{potion.Poison(), potion.LongPoison()},
+		{potion.Regeneration(), potion.LongRegeneration()},
+		{potion.Strength(), potion.LongStrength()},
+		{potion.Weakness(), potion.LongWeakness()},
+		{potion.TurtleMaster(), potion.LongTurtleMaster()},
+		{potion.SlowFalling(), potion.LongSlowFalling()},
+	} {
+		mix(p[0], item.RedstoneDust{}, p[1])
+	}
+	for _, p := range [][2]potion.Potion{
+		{potion.Leaping(), potion.StrongLeaping()},
+		{potion.Swiftness(), potion.StrongSwiftness()},
+		{potion.Slowness(), potion.StrongSlowness()},
+		{potion.Healing(), potion.StrongHealing()},
+		{potion.Harming(), potion.StrongHarming()},
+		{potion.Poison(), potion.StrongPoison()},
+		{potion.Regeneration(), potion.StrongRegeneration()},
+		{potion.Strength(), potion.StrongStrength()},
+		{potion.TurtleMaster(), potion.StrongTurtleMaster()},
+	} {
+		mix(p[0], item.GlowstoneDust{}, p[1])
+	}
+
+	Register(NewPotionContainerChange(item.NewStack(item.Potion{}, 1), item.NewStack(item.Gunpowder{}, 1), item.NewStack(item.SplashPotion{}, 1), stand))
+	Register(NewPotionContainerChange(item.NewStack(item.SplashPotion{}, 1), item.NewStack(item.DragonBreath{}, 1), item.NewStack(item.LingeringPotion{}, 1), stand))
+}
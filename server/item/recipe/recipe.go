@@ -59,6 +59,39 @@ func NewSmithingTrim(base, addition, template Item, block string) SmithingTrim {
 	}}
 }
 
+// Potion is a recipe brewed in a brewing stand that turns a potion of one type into a potion of another type using a
+// reagent, such as an awkward potion and a ghast tear into a potion of regeneration. The container of the potion is
+// kept, so the same recipe also turns an awkward splash potion into a splash potion of regeneration.
+type Potion struct {
+	recipe
+}
+
+// NewPotion creates a new potion recipe and returns it. The input and output must both hold an item.Potion, of which
+// only the potion type is used. The recipe can only be brewed on the block passed.
+func NewPotion(input, reagent Item, output item.Stack, block string) Potion {
+	return Potion{recipe: recipe{
+		input:  []Item{input, reagent},
+		output: []item.Stack{output},
+		block:  block,
+	}}
+}
+
+// PotionContainerChange is a recipe brewed in a brewing stand that changes the container of a potion using a
+// reagent, such as a potion and gunpowder into a splash potion. The type of the potion is kept.
+type PotionContainerChange struct {
+	recipe
+}
+
+// NewPotionContainerChange creates a new potion container change recipe and returns it. The potion type of the
+// input and output items is disregarded. The recipe can only be brewed on the block passed.
+func NewPotionContainerChange(input, reagent Item, output item.Stack, block string) PotionContainerChange {
+	return PotionContainerChange{recipe: recipe{
+		input:  []Item{input, reagent},
+		output: []item.Stack{output},
+		block:  block,
+	}}
+}
+
 // Shaped is a recipe that has a specific shape that must be used to craft the output of the recipe.
 type Shaped struct {
 	recipe
@@ -4,8 +4,12 @@ import (
 	"slices"
 )
 
-// recipes is a list of each recipe.
-var recipes []Recipe
+var (
+	// recipes is a list of each recipe.
+	recipes []Recipe
+	// brewing is a list of each Potion and PotionContainerChange recipe.
+	brewing []Recipe
+)
 
 // Recipes returns each recipe in a slice.
 func Recipes() []Recipe {
@@ -15,4 +19,8 @@ func Recipes() []Recipe {
 // Register registers a new recipe.
 func Register(recipe Recipe) {
 	recipes = append(recipes, recipe)
+	switch recipe.(type) {
+	case Potion, PotionContainerChange:
+		brewing = append(brewing, recipe)
+	}
 }
@@ -2,9 +2,10 @@ package recipe
 
 import (
 	_ "embed"
+
 	// Ensure all blocks and items are registered before trying to load vanilla recipes.
-	_ "github.com/df-mc/dragonfly/server/block"
-	_ "github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
 	"github.com/sandertv/gophertunnel/minecraft/nbt"
 )
 
@@ -114,4 +115,17 @@ func init() {
 			priority: uint32(s.Priority),
 		}})
 	}
+
+	registerVanillaBrewing()
+	block.RegisterBrewingRecipes(brewingRecipes{})
+	registerShieldDecoration()
+}
+
+// registerShieldDecoration registers the recipe used to apply a banner to a shield in the crafting grid. The output
+// of the recipe is an undecorated shield: the banner used is applied to it when the recipe is crafted.
+func registerShieldDecoration() {
+	Register(NewShapeless([]Item{
+		item.NewStack(item.Shield{}, 1),
+		item.NewStack(block.Banner{}, 1).WithValue("variants", true),
+	}, item.NewStack(item.Shield{}, 1), "crafting_table"))
 }
@@ -0,0 +1,10 @@
+package item
+
+// RedstoneDust is an item obtained from redstone ore. It is used as a brewing ingredient to extend the duration of
+// potions.
+type RedstoneDust struct{}
+
+// EncodeItem ...
+func (RedstoneDust) EncodeItem() (name string, meta int16) {
+	return "minecraft:redstone", 0
+}
@@ -30,6 +30,7 @@ func init() {
 	world.RegisterItem(Bucket{})
 	world.RegisterItem(CarrotOnAStick{})
 	world.RegisterItem(Charcoal{})
+	world.RegisterItem(ChestMinecart{})
 	world.RegisterItem(Chicken{Cooked: true})
 	world.RegisterItem(Chicken{})
 	world.RegisterItem(ClayBall{})
@@ -55,6 +56,7 @@ func init() {
 	world.RegisterItem(FermentedSpiderEye{})
 	world.RegisterItem(FireCharge{})
 	world.RegisterItem(Firework{})
+	world.RegisterItem(FishingRod{})
 	world.RegisterItem(FlintAndSteel{})
 	world.RegisterItem(Flint{})
 	world.RegisterItem(GhastTear{})
@@ -68,6 +70,7 @@ func init() {
 	world.RegisterItem(Gunpowder{})
 	world.RegisterItem(HeartOfTheSea{})
 	world.RegisterItem(Honeycomb{})
+	world.RegisterItem(HopperMinecart{})
 	world.RegisterItem(InkSac{Glowing: true})
 	world.RegisterItem(InkSac{})
 	world.RegisterItem(IronIngot{})
@@ -76,6 +79,7 @@ func init() {
 	world.RegisterItem(Leather{})
 	world.RegisterItem(MagmaCream{})
 	world.RegisterItem(MelonSlice{})
+	world.RegisterItem(Minecart{})
 	world.RegisterItem(MushroomStew{})
 	world.RegisterItem(Mutton{Cooked: true})
 	world.RegisterItem(Mutton{})
@@ -104,18 +108,22 @@ func init() {
 	world.RegisterItem(RawGold{})
 	world.RegisterItem(RawIron{})
 	world.RegisterItem(RecoveryCompass{})
+	world.RegisterItem(RedstoneDust{})
 	world.RegisterItem(RottenFlesh{})
 	world.RegisterItem(Salmon{Cooked: true})
 	world.RegisterItem(Salmon{})
 	world.RegisterItem(Scute{})
 	world.RegisterItem(Shears{})
+	world.RegisterItem(Shield{})
 	world.RegisterItem(ShulkerShell{})
 	world.RegisterItem(Slimeball{})
 	world.RegisterItem(Snowball{})
 	world.RegisterItem(SpiderEye{})
 	world.RegisterItem(Spyglass{})
 	world.RegisterItem(Stick{})
+	world.RegisterItem(String{})
 	world.RegisterItem(Sugar{})
+	world.RegisterItem(TNTMinecart{})
 	world.RegisterItem(Totem{})
 	world.RegisterItem(TropicalFish{})
 	world.RegisterItem(TurtleShell{})
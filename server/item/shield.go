@@ -0,0 +1,65 @@
+package item
+
+import "github.com/df-mc/dragonfly/server/world"
+
+// Shield is a tool used to protect the user from attacks and projectiles. A user holding a shield blocks damage
+// coming from the front while sneaking.
+type Shield struct {
+	// Banner is the banner applied to the shield, typically a block.Banner. The shield shows the colour and patterns
+	// of the banner. If nil, the shield is not decorated.
+	Banner world.Item
+}
+
+// MaxCount ...
+func (Shield) MaxCount() int {
+	return 1
+}
+
+// OffHand ...
+func (Shield) OffHand() bool {
+	return true
+}
+
+// DurabilityInfo ...
+func (Shield) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 336,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// RepairableBy ...
+func (Shield) RepairableBy(i Stack) bool {
+	if planks, ok := i.Item().(interface{ RepairsWoodTools() bool }); ok {
+		return planks.RepairsWoodTools()
+	}
+	return false
+}
+
+// EncodeNBT ...
+func (s Shield) EncodeNBT() map[string]any {
+	banner, ok := s.Banner.(world.NBTer)
+	if !ok {
+		return nil
+	}
+	data := banner.EncodeNBT()
+	return map[string]any{"Base": data["Base"], "Patterns": data["Patterns"]}
+}
+
+// DecodeNBT ...
+func (s Shield) DecodeNBT(data map[string]any) any {
+	if _, ok := data["Base"]; !ok {
+		return s
+	}
+	if it, ok := world.ItemByName("minecraft:banner", 0); ok {
+		if banner, ok := it.(world.NBTer); ok {
+			s.Banner, _ = banner.DecodeNBT(data).(world.Item)
+		}
+	}
+	return s
+}
+
+// EncodeItem ...
+func (Shield) EncodeItem() (name string, meta int16) {
+	return "minecraft:shield", 0
+}
@@ -97,6 +97,17 @@ func (s Stack) Grow(n int) Stack {
 	return s
 }
 
+// WithItem returns a new Stack with the item type changed to the one passed. All other properties of the Stack,
+// such as its count, damage, custom name, lore and enchantments, are kept.
+func (s Stack) WithItem(t world.Item) Stack {
+	if t == nil {
+		panic("cannot have a stack with item type nil")
+	}
+	s.item = t
+	s.id = newID()
+	return s
+}
+
 // Durability returns the current durability of the item stack. If the item is not one that implements the
 // Durable interface, BaseDurability will always return -1.
 // The closer the durability returned is to 0, the closer the item is to being broken.
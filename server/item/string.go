@@ -0,0 +1,10 @@
+package item
+
+// String is an item dropped by spiders and obtained from cobwebs. It is used to craft bows, fishing rods and
+// wool.
+type String struct{}
+
+// EncodeItem ...
+func (String) EncodeItem() (name string, meta int16) {
+	return "minecraft:string", 0
+}
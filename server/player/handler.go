@@ -3,6 +3,7 @@ package player
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/player/skin"
@@ -30,6 +31,16 @@ type Handler interface {
 	// HandleToggleSneak handles when the player starts or stops sneaking.
 	// After is true if the player is sneaking after toggling (changing their sneaking state).
 	HandleToggleSneak(ctx *event.Context, after bool)
+	// HandleMount handles the player starting to ride the entity passed. ctx.Cancel() may be called to
+	// prevent the player from riding the entity.
+	HandleMount(ctx *event.Context, e world.Entity)
+	// HandleDismount handles the player stopping to ride the entity passed. ctx.Cancel() may be called to
+	// keep the player riding the entity. Dismounting that is the result of the player dying, quitting or the
+	// entity being removed cannot be cancelled.
+	HandleDismount(ctx *event.Context, e world.Entity)
+	// HandleSleep handles the player going to sleep in the bed with its head at the position passed.
+	// ctx.Cancel() may be called to prevent the player from sleeping.
+	HandleSleep(ctx *event.Context, pos cube.Pos)
 	// HandleChat handles a message sent in the chat by a player. ctx.Cancel() may be called to cancel the
 	// message being sent in chat.
 	// The message may be changed by assigning to *message.
@@ -110,6 +121,13 @@ type Handler interface {
 	// HandleLecternPageTurn handles the player turning a page in a lectern. ctx.Cancel() may be called to cancel the
 	// page turn. The page number may be changed by assigning to *page.
 	HandleLecternPageTurn(ctx *event.Context, pos cube.Pos, oldPage int, newPage *int)
+	// HandleTrade handles the player completing a trade with a villager. The trade used is passed. ctx.Cancel() may
+	// be called to cancel the trade.
+	HandleTrade(ctx *event.Context, e world.Entity, trade entity.Trade)
+	// HandleFish handles the player catching an item by reeling in a fishing hook that a fish was biting. The
+	// hook entity is passed. The item caught and the experience dropped may be changed. ctx.Cancel() may be
+	// called to cancel the catch, in which case nothing is caught.
+	HandleFish(ctx *event.Context, hook world.Entity, it *item.Stack, xp *int)
 	// HandleItemDamage handles the event wherein the item either held by the player or as armour takes
 	// damage through usage.
 	// The type of the item may be checked to determine whether it was armour or a tool used. The damage to
@@ -148,6 +166,9 @@ func (NopHandler) HandleTeleport(*event.Context, mgl64.Vec3)
 func (NopHandler) HandleChangeWorld(*world.World, *world.World)                               {}
 func (NopHandler) HandleToggleSprint(*event.Context, bool)                                    {}
 func (NopHandler) HandleToggleSneak(*event.Context, bool)                                     {}
+func (NopHandler) HandleMount(*event.Context, world.Entity)                                   {}
+func (NopHandler) HandleDismount(*event.Context, world.Entity)                                {}
+func (NopHandler) HandleSleep(*event.Context, cube.Pos)                                       {}
 func (NopHandler) HandleCommandExecution(*event.Context, cmd.Command, []string)               {}
 func (NopHandler) HandleTransfer(*event.Context, *net.UDPAddr)                                {}
 func (NopHandler) HandleChat(*event.Context, *string)                                         {}
@@ -158,6 +179,8 @@ func (NopHandler) HandleBlockPlace(*event.Context, cube.Pos, world.Block)
 func (NopHandler) HandleBlockPick(*event.Context, cube.Pos, world.Block)                      {}
 func (NopHandler) HandleSignEdit(*event.Context, bool, string, string)                        {}
 func (NopHandler) HandleLecternPageTurn(*event.Context, cube.Pos, int, *int)                  {}
+func (NopHandler) HandleTrade(*event.Context, world.Entity, entity.Trade)                     {}
+func (NopHandler) HandleFish(*event.Context, world.Entity, *item.Stack, *int)                 {}
 func (NopHandler) HandleItemPickup(*event.Context, *item.Stack)                               {}
 func (NopHandler) HandleItemUse(*event.Context)                                               {}
 func (NopHandler) HandleItemUseOnBlock(*event.Context, cube.Pos, cube.Face, mgl64.Vec3)       {}
@@ -63,7 +63,7 @@ type Player struct {
 	heldSlot                 *atomic.Uint32
 
 	sneaking, sprinting, swimming, gliding, flying,
-	invisible, immobile, onGround, usingItem atomic.Bool
+	invisible, immobile, onGround, usingItem, blocking, shieldRaised atomic.Bool
 	usingSince atomic.Int64
 
 	glideTicks   atomic.Int64
@@ -103,6 +103,16 @@ type Player struct {
 
 	breakParticleCounter atomic.Uint32
 
+	riding atomic.Value[entity.Rideable]
+
+	fishingHook atomic.Value[*entity.Ent]
+
+	sleeping   atomic.Bool
+	sleepPos   atomic.Value[cube.Pos]
+	sleepTicks atomic.Int64
+
+	lastAttacker, lastAttacked atomic.Value[combatant]
+
 	hunger *hungerManager
 }
 
@@ -574,6 +584,10 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 	if dmg < 0 {
 		return 0, true
 	}
+	if p.blockedByShield(dmg, src) {
+		return 0, false
+	}
+	p.Wake()
 
 	totalDamage := p.FinalDamageFrom(dmg, src)
 	damageLeft := totalDamage
@@ -604,15 +618,18 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 
 	p.addHealth(-damageLeft)
 
+	var origin world.Entity
+	if s, ok := src.(entity.AttackDamageSource); ok {
+		origin = s.Attacker
+	} else if s, ok := src.(entity.ProjectileDamageSource); ok {
+		origin = s.Owner
+	}
+	if origin != nil {
+		p.lastAttacker.Store(combatant{e: origin, t: time.Now()})
+	}
 	if src.ReducedByArmour() {
 		p.Exhaust(0.1)
 		p.Armour().Damage(dmg, p.damageItem)
-		var origin world.Entity
-		if s, ok := src.(entity.AttackDamageSource); ok {
-			origin = s.Attacker
-		} else if s, ok := src.(entity.ProjectileDamageSource); ok {
-			origin = s.Owner
-		}
 		if l, ok := origin.(entity.Living); ok {
 			thornsDmg := p.Armour().ThornsDamage(p.damageItem)
 			if thornsDmg > 0 {
@@ -638,6 +655,85 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 	return totalDamage, true
 }
 
+// blockedByShield checks if the damage from the world.DamageSource passed is blocked by a shield. This is the case if
+// the player is blocking and the damage is dealt by an attack or projectile coming from the front. Blocking damages
+// the shield and knocks back the attacker. Blocking an attack by an axe disables the shield for 5 seconds.
+func (p *Player) blockedByShield(dmg float64, src world.DamageSource) bool {
+	if !p.Blocking() {
+		return false
+	}
+	var origin world.Entity
+	switch s := src.(type) {
+	case entity.AttackDamageSource:
+		origin = s.Attacker
+	case entity.ProjectileDamageSource:
+		origin = s.Projectile
+	}
+	if origin == nil {
+		return false
+	}
+	dir, look := origin.Position().Sub(p.Position()), p.Rotation().Vec3()
+	if dir[0]*look[0]+dir[2]*look[2] <= 0 {
+		// The damage came from behind the player, so the shield can't block it.
+		return false
+	}
+	p.World().PlaySound(p.Position(), sound.ShieldBlock{})
+	if dmg >= 3 {
+		p.damageShield(1 + int(math.Floor(dmg)))
+	}
+	if s, ok := src.(entity.AttackDamageSource); ok {
+		if l, ok := s.Attacker.(entity.Living); ok {
+			l.KnockBack(p.Position(), 0.5, 0.4)
+		}
+		if h, ok := s.Attacker.(interface {
+			HeldItems() (item.Stack, item.Stack)
+		}); ok {
+			held, _ := h.HeldItems()
+			if t, ok := held.Item().(item.Tool); ok && t.ToolType() == item.TypeAxe {
+				p.SetCooldown(item.Shield{}, time.Second*5)
+				p.updateShield()
+			}
+		}
+	}
+	return true
+}
+
+// damageShield damages the shield held by the player by the amount of durability passed. A shield held in the off
+// hand is damaged before a shield held in the main hand.
+func (p *Player) damageShield(d int) {
+	mainHand, offHand := p.HeldItems()
+	if _, ok := offHand.Item().(item.Shield); ok {
+		p.SetHeldItems(mainHand, p.damageItem(offHand, d))
+		return
+	}
+	p.SetHeldItems(p.damageItem(mainHand, d), offHand)
+}
+
+// combatant is an entity that attacked a player or was attacked by it, together with the time at which the
+// attack happened.
+type combatant struct {
+	e world.Entity
+	t time.Time
+}
+
+// LastAttacker returns the entity that last attacked the player, either directly or using a projectile. False
+// is returned if the player was not attacked in the last 5 seconds.
+func (p *Player) LastAttacker() (world.Entity, bool) {
+	if c := p.lastAttacker.Load(); c.e != nil && time.Since(c.t) < time.Second*5 {
+		return c.e, true
+	}
+	return nil, false
+}
+
+// LastAttacked returns the entity that the player last attacked. False is returned if the player did not
+// attack an entity in the last 5 seconds.
+func (p *Player) LastAttacked() (world.Entity, bool) {
+	if c := p.lastAttacked.Load(); c.e != nil && time.Since(c.t) < time.Second*5 {
+		return c.e, true
+	}
+	return nil, false
+}
+
 // applyTotemEffects is an unexported function that is used to handle totem effects.
 func (p *Player) applyTotemEffects() {
 	p.addHealth(2 - p.Health())
@@ -852,6 +948,10 @@ func (p *Player) kill(src world.DamageSource) {
 	p.Handler().HandleDeath(src, &keepInv)
 	p.StopSneaking()
 	p.StopSprinting()
+	p.Wake()
+	if v, ok := p.RidingEntity(); ok {
+		p.dismount(v)
+	}
 
 	w, pos := p.World(), p.Position()
 	if !keepInv {
@@ -918,7 +1018,7 @@ func (p *Player) Respawn() {
 	// We can use the principle here that returning through a portal of a specific dimension inside that dimension will
 	// always bring us back to the overworld.
 	w = w.PortalDestination(w.Dimension())
-	pos := w.PlayerSpawn(p.UUID()).Vec3Middle()
+	pos := p.spawnPosition(w)
 
 	p.Handler().HandleRespawn(&pos, &w)
 
@@ -929,6 +1029,94 @@ func (p *Player) Respawn() {
 	p.SetVisible()
 }
 
+// spawnPosition returns the position in the world passed that the player should respawn at. If the spawn
+// point of the player is a bed, a free position next to the bed is returned. If no such position exists, the
+// spawn point is reset to the spawn of the world. Other spawn points are returned as is.
+func (p *Player) spawnPosition(w *world.World) mgl64.Vec3 {
+	spawn := w.PlayerSpawn(p.UUID())
+	b, ok := w.Block(spawn).(block.Bed)
+	if !ok {
+		return spawn.Vec3Middle()
+	}
+	if pos, ok := bedSpawnPosition(w, spawn, b); ok {
+		return pos
+	}
+	p.Message("You have no home bed or it was obstructed")
+	w.SetPlayerSpawn(p.UUID(), w.Spawn())
+	return w.Spawn().Vec3Middle()
+}
+
+// bedSpawnPosition looks for a free position around the bed with its head at the position passed that a
+// player is able to respawn at. If no such position exists, false is returned.
+func bedSpawnPosition(w *world.World, head cube.Pos, b block.Bed) (mgl64.Vec3, bool) {
+	foot := head.Side(b.Facing.Opposite().Face())
+	for _, half := range []cube.Pos{head, foot} {
+		for _, y := range []int{0, -1, 1} {
+			for x := -1; x <= 1; x++ {
+				for z := -1; z <= 1; z++ {
+					pos := half.Add(cube.Pos{x, y, z})
+					if below := pos.Side(cube.FaceDown); w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) && !obstructed(w, pos) {
+						return pos.Vec3Middle(), true
+					}
+				}
+			}
+		}
+	}
+	if above := head.Side(cube.FaceUp); !obstructed(w, above) {
+		return head.Vec3Middle().Add(mgl64.Vec3{0, 0.5625}), true
+	}
+	return mgl64.Vec3{}, false
+}
+
+// obstructed checks if a player standing at the position passed would be obstructed by the blocks around it.
+func obstructed(w *world.World, pos cube.Pos) bool {
+	for _, p := range []cube.Pos{pos, pos.Side(cube.FaceUp)} {
+		if len(w.Block(p).Model().BBox(p, w)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// StartBlocking makes the player start blocking with a shield. The player only actually blocks while holding a
+// shield in either hand that is not disabled. If the player is already blocking, StartBlocking will not do
+// anything.
+func (p *Player) StartBlocking() {
+	if !p.blocking.CAS(false, true) {
+		return
+	}
+	p.updateShield()
+}
+
+// Blocking checks if the player is currently blocking with a shield. This is the case if the player started
+// blocking and holds a shield in either hand that is not disabled by an axe.
+func (p *Player) Blocking() bool {
+	if !p.blocking.Load() || p.HasCooldown(item.Shield{}) {
+		return false
+	}
+	mainHand, offHand := p.HeldItems()
+	_, mainShield := mainHand.Item().(item.Shield)
+	_, offShield := offHand.Item().(item.Shield)
+	return mainShield || offShield
+}
+
+// StopBlocking makes the player stop blocking with a shield if it currently is. If the player is not blocking,
+// StopBlocking will not do anything.
+func (p *Player) StopBlocking() {
+	if !p.blocking.CAS(true, false) {
+		return
+	}
+	p.updateShield()
+}
+
+// updateShield updates the state of the player for viewers if it raised or lowered its shield, which may happen
+// when it starts or stops blocking, switches held items or when its shield is no longer disabled.
+func (p *Player) updateShield() {
+	if blocking := p.Blocking(); p.shieldRaised.Swap(blocking) != blocking {
+		p.updateState()
+	}
+}
+
 // StartSprinting makes a player start sprinting, increasing the speed of the player by 30% and making
 // particles show up under the feet. The player will only start sprinting if its food level is high enough.
 // If the player is sneaking when calling StartSprinting, it is stopped from sneaking.
@@ -983,6 +1171,7 @@ func (p *Player) StartSneaking() {
 		p.StopSprinting()
 	}
 	p.updateState()
+	p.DismountEntity()
 }
 
 // Sneaking checks if the player is currently sneaking.
@@ -1096,6 +1285,181 @@ func (p *Player) Jump() {
 	}
 }
 
+// RideEntity makes the player start riding the entity passed, provided it has a free seat. If the player was
+// already riding another entity, it is dismounted from that entity first.
+func (p *Player) RideEntity(e entity.Rideable) {
+	if v, ok := p.RidingEntity(); ok && v == e {
+		return
+	}
+	ctx := event.C()
+	if p.Handler().HandleMount(ctx, e); ctx.Cancelled() {
+		return
+	}
+	if v, ok := p.RidingEntity(); ok {
+		p.dismount(v)
+	}
+	p.Wake()
+	if !e.AddPassenger(p) {
+		return
+	}
+	p.riding.Store(e)
+	p.StopSprinting()
+
+	passengers := e.Passengers()
+	driver := len(passengers) > 0 && passengers[0] == entity.Rider(p)
+	for _, v := range p.viewers() {
+		v.ViewEntityMount(p, e, driver)
+	}
+	p.updateState()
+}
+
+// DismountEntity makes the player stop riding the entity it is currently riding. The player is placed on
+// top of the entity. DismountEntity does nothing if the player is not riding any entity.
+func (p *Player) DismountEntity() {
+	e, ok := p.RidingEntity()
+	if !ok {
+		return
+	}
+	ctx := event.C()
+	if p.Handler().HandleDismount(ctx, e); ctx.Cancelled() {
+		return
+	}
+	p.dismount(e)
+}
+
+// dismount makes the player stop riding the entity passed without calling the Handler of the player.
+func (p *Player) dismount(e entity.Rideable) {
+	if p.riding.Swap(nil) == nil {
+		return
+	}
+	e.RemovePassenger(p)
+	for _, v := range p.viewers() {
+		v.ViewEntityDismount(p, e)
+	}
+	p.updateState()
+	if !p.Dead() {
+		p.teleport(e.Position().Add(mgl64.Vec3{0, e.Type().BBox(e).Height()}))
+	}
+}
+
+// RidingEntity returns the entity that the player is currently riding. If the player is not riding any
+// entity, false is returned.
+func (p *Player) RidingEntity() (entity.Rideable, bool) {
+	e := p.riding.Load()
+	return e, e != nil
+}
+
+// SteerVehicle passes the movement input of the player to the entity it is riding, so that the entity may
+// be steered if the player is its driver. SteerVehicle does nothing if the player is not riding any entity.
+func (p *Player) SteerVehicle(input entity.RiderInput) {
+	if v, ok := p.RidingEntity(); ok {
+		if st, ok := v.(interface {
+			Steer(r entity.Rider, input entity.RiderInput)
+		}); ok {
+			st.Steer(p, input)
+		}
+	}
+}
+
+// Sleep makes the player start sleeping in the bed with its head at the position passed. Sleep does nothing
+// if the player is already sleeping or if the bed is occupied. Once enough players in the world are
+// sleeping, the night is skipped.
+func (p *Player) Sleep(pos cube.Pos) {
+	if _, ok := p.Sleeping(); ok {
+		return
+	}
+	w := p.World()
+	if b, ok := w.Block(pos).(block.Bed); !ok || b.Occupied {
+		return
+	}
+	ctx := event.C()
+	if p.Handler().HandleSleep(ctx, pos); ctx.Cancelled() {
+		return
+	}
+	if v, ok := p.RidingEntity(); ok {
+		p.dismount(v)
+	}
+	p.StopSprinting()
+	setBedOccupied(w, pos, true)
+
+	p.sleepTicks.Store(0)
+	p.sleepPos.Store(pos)
+	p.sleeping.Store(true)
+	p.teleport(pos.Vec3Middle().Add(mgl64.Vec3{0, 0.5625}))
+	p.updateState()
+}
+
+// Wake makes the player stop sleeping. Wake does nothing if the player is not sleeping.
+func (p *Player) Wake() {
+	if !p.sleeping.CAS(true, false) {
+		return
+	}
+	setBedOccupied(p.World(), p.sleepPos.Load(), false)
+	for _, v := range p.viewers() {
+		v.ViewEntityAction(p, entity.WakeUpAction{})
+	}
+	p.updateState()
+}
+
+// Sleeping returns the position of the head of the bed that the player is sleeping in. If the player is not
+// sleeping, false is returned.
+func (p *Player) Sleeping() (cube.Pos, bool) {
+	if !p.sleeping.Load() {
+		return cube.Pos{}, false
+	}
+	return p.sleepPos.Load(), true
+}
+
+// tickSleep wakes the player if the bed it is sleeping in was removed and skips the night once enough
+// players in the world have been sleeping for long enough.
+func (p *Player) tickSleep(w *world.World) {
+	pos, ok := p.Sleeping()
+	if !ok {
+		return
+	}
+	if _, ok := w.Block(pos).(block.Bed); !ok {
+		p.Wake()
+		return
+	}
+	if p.sleepTicks.Inc() < 100 {
+		return
+	}
+	var players, sleeping []*Player
+	for _, e := range w.Entities() {
+		if other, ok := e.(*Player); ok && other.GameMode().Visible() {
+			players = append(players, other)
+			if _, ok := other.Sleeping(); ok && other.sleepTicks.Load() >= 100 {
+				sleeping = append(sleeping, other)
+			}
+		}
+	}
+	if len(sleeping)*100 < len(players)*w.PlayersSleepingPercentage() {
+		return
+	}
+	t := w.Time()
+	w.SetTime(t - t%24000 + 24000)
+	w.StopRaining()
+	w.StopThundering()
+	for _, other := range players {
+		other.Wake()
+	}
+}
+
+// setBedOccupied changes whether the bed with its head at the position passed is occupied.
+func setBedOccupied(w *world.World, pos cube.Pos, occupied bool) {
+	b, ok := w.Block(pos).(block.Bed)
+	if !ok {
+		return
+	}
+	b.Occupied = occupied
+	w.SetBlock(pos, b, nil)
+	foot := pos.Side(b.Facing.Opposite().Face())
+	if f, ok := w.Block(foot).(block.Bed); ok {
+		f.Occupied = occupied
+		w.SetBlock(foot, f, nil)
+	}
+}
+
 // SetInvisible sets the player invisible, so that other players will not be able to see it.
 func (p *Player) SetInvisible() {
 	if !p.invisible.CAS(false, true) {
@@ -1489,6 +1853,9 @@ func (p *Player) UseItemOnEntity(e world.Entity) bool {
 	if p.Handler().HandleItemUseOnEntity(ctx, e); ctx.Cancelled() {
 		return false
 	}
+	if in, ok := e.(interface{ Interact(user item.User) bool }); ok && (!p.Sneaking() || sneakInteractable(e)) && in.Interact(p) {
+		return true
+	}
 	i, left := p.HeldItems()
 	usable, ok := i.Item().(item.UsableOnEntity)
 	if !ok {
@@ -1504,6 +1871,13 @@ func (p *Player) UseItemOnEntity(e world.Entity) bool {
 	return true
 }
 
+// sneakInteractable checks if the entity passed may be interacted with while sneaking, such as a chest boat of which
+// the inventory is opened by sneaking.
+func sneakInteractable(e world.Entity) bool {
+	s, ok := e.(interface{ SneakInteractable() bool })
+	return ok && s.SneakInteractable()
+}
+
 // AttackEntity uses the item held in the main hand of the player to attack the entity passed, provided it is
 // within range of the player.
 // The damage dealt to the entity will depend on the item held by the player and any effects the player may
@@ -1529,6 +1903,11 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 	i, _ := p.HeldItems()
 	living, ok := e.(entity.Living)
 	if !ok {
+		if d, ok := e.(interface {
+			Damage(damage float64, src world.DamageSource) bool
+		}); ok {
+			return d.Damage(i.AttackDamage(), entity.AttackDamageSource{Attacker: p})
+		}
 		return false
 	}
 	if living.AttackImmune() {
@@ -1545,6 +1924,18 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 	if s, ok := i.Enchantment(enchantment.Sharpness{}); ok {
 		dmg += (enchantment.Sharpness{}).Addend(s.Level())
 	}
+	if s, ok := i.Enchantment(enchantment.Smite{}); ok {
+		if u, ok := e.(interface{ Undead() bool }); ok && u.Undead() {
+			dmg += (enchantment.Smite{}).Addend(s.Level())
+		}
+	}
+	var slowness time.Duration
+	if b, ok := i.Enchantment(enchantment.BaneOfArthropods{}); ok {
+		if a, ok := e.(interface{ Arthropod() bool }); ok && a.Arthropod() {
+			dmg += (enchantment.BaneOfArthropods{}).Addend(b.Level())
+			slowness = (enchantment.BaneOfArthropods{}).SlownessDuration(b.Level())
+		}
+	}
 	if critical {
 		dmg *= 1.5
 	}
@@ -1556,6 +1947,7 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 	if !vulnerable {
 		return true
 	}
+	p.lastAttacked.Store(combatant{e: e, t: time.Now()})
 	if critical {
 		for _, v := range p.World().Viewers(living.Position()) {
 			v.ViewEntityAction(living, entity.CriticalHitAction{})
@@ -1576,6 +1968,9 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 			flammable.SetOnFire((enchantment.FireAspect{}).Duration(f.Level()))
 		}
 	}
+	if slowness > 0 {
+		living.AddEffect(effect.New(effect.Slowness{}, 4, slowness))
+	}
 
 	if durable, ok := i.Item().(item.Durable); ok {
 		p.SetHeldItems(p.damageItem(i, durable.DurabilityInfo().AttackDurability), left)
@@ -1841,19 +2236,25 @@ func (p *Player) drops(held item.Stack, b world.Block) []item.Stack {
 		t = item.ToolNone{}
 	}
 	var drops []item.Stack
-	if container, ok := b.(block.Container); ok {
+	if shulkerBox, ok := b.(block.ShulkerBox); ok {
+		// Shulker boxes keep their inventory contents when broken, so they drop as an item holding them. In
+		// creative mode, they are only dropped if they hold any items.
+		if !p.GameMode().CreativeInventory() || !shulkerBox.Inventory().Empty() {
+			drops = shulkerBox.BreakInfo().Drops(t, held.Enchantments())
+		}
+	} else if container, ok := b.(block.Container); ok {
 		// If the block is a container, it should drop its inventory contents regardless whether the
 		// player is in creative mode or not.
 		drops = container.Inventory().Items()
 		if breakable, ok := b.(block.Breakable); ok && !p.GameMode().CreativeInventory() {
 			if breakable.BreakInfo().Harvestable(t) {
-				drops = append(drops, breakable.BreakInfo().Drops(t, held.Enchantments())...)
+				drops = append(drops, block.BreakDrops(breakable, t, held.Enchantments())...)
 			}
 		}
 		container.Inventory().Clear()
 	} else if breakable, ok := b.(block.Breakable); ok && !p.GameMode().CreativeInventory() {
 		if breakable.BreakInfo().Harvestable(t) {
-			drops = breakable.BreakInfo().Drops(t, held.Enchantments())
+			drops = block.BreakDrops(breakable, t, held.Enchantments())
 		}
 	} else if it, ok := b.(world.Item); ok && !p.GameMode().CreativeInventory() {
 		drops = []item.Stack{item.NewStack(it, 1)}
@@ -1921,6 +2322,8 @@ func (p *Player) Teleport(pos mgl64.Vec3) {
 	if p.Handler().HandleTeleport(ctx, pos); ctx.Cancelled() {
 		return
 	}
+	p.DismountEntity()
+	p.Wake()
 	p.teleport(pos)
 }
 
@@ -2002,6 +2405,9 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 
 	p.onGround.Store(p.checkOnGround(w))
 	p.updateFallState(deltaPos[1])
+	if p.OnGround() {
+		p.frostWalk(res, w)
+	}
 
 	if p.Swimming() {
 		p.Exhaust(0.01 * horizontalVel.Len())
@@ -2211,6 +2617,15 @@ func (p *Player) OpenBlockContainer(pos cube.Pos) {
 	}
 }
 
+// OpenEntityContainer opens the inventory of an entity, such as a chest minecart. If the entity passed does
+// not have an inventory, OpenEntityContainer does nothing.
+// OpenEntityContainer will also do nothing if the player has no session connected to it.
+func (p *Player) OpenEntityContainer(e world.Entity) {
+	if p.session() != session.Nop {
+		p.session().OpenEntityContainer(e)
+	}
+}
+
 // HideEntity hides a world.Entity from the Player so that it can under no circumstance see it. Hidden entities can be
 // made visible again through a call to ShowEntity.
 func (p *Player) HideEntity(e world.Entity) {
@@ -2247,6 +2662,13 @@ func (p *Player) Tick(w *world.World, current int64) {
 		p.Handler().HandleChangeWorld(p.lastTickedWorld, w)
 	}
 	p.lastTickedWorld = w
+	if v, ok := p.RidingEntity(); ok {
+		if _, ok := world.OfEntity(v); !ok || sliceutil.Index(v.Passengers(), entity.Rider(p)) == -1 {
+			// The entity ridden was removed or no longer has the player seated.
+			p.dismount(v)
+		}
+	}
+	p.tickSleep(w)
 	if _, ok := w.Liquid(cube.PosFromVec3(p.Position())); !ok {
 		p.StopSwimming()
 		if _, ok := p.Armour().Helmet().Item().(item.TurtleShell); ok {
@@ -2264,6 +2686,8 @@ func (p *Player) Tick(w *world.World, current int64) {
 		}
 	}
 
+	p.updateShield()
+
 	p.checkBlockCollisions(p.vel.Load(), w)
 	p.onGround.Store(p.checkOnGround(w))
 
@@ -2468,6 +2892,33 @@ func (p *Player) insideOfSolid(w *world.World) bool {
 	return false
 }
 
+// frostWalk turns water source blocks around the position passed into frosted ice if the player is wearing boots
+// enchanted with Frost Walker.
+func (p *Player) frostWalk(pos mgl64.Vec3, w *world.World) {
+	e, ok := p.Armour().Boots().Enchantment(enchantment.FrostWalker{})
+	if !ok {
+		return
+	}
+	r := (enchantment.FrostWalker{}).Radius(e.Level())
+	below := cube.PosFromVec3(pos).Side(cube.FaceDown)
+	for x := -r; x <= r; x++ {
+		for z := -r; z <= r; z++ {
+			if x*x+z*z > r*r {
+				continue
+			}
+			bp := below.Add(cube.Pos{x, 0, z})
+			if _, air := w.Block(bp.Side(cube.FaceUp)).(block.Air); !air {
+				continue
+			}
+			if water, ok := w.Block(bp).(block.Water); !ok || water.Depth != 8 || water.Falling {
+				continue
+			}
+			w.SetBlock(bp, block.FrostedIce{}, nil)
+			w.ScheduleBlockUpdate(bp, time.Duration(60+rand.Intn(60))*time.Second/20)
+		}
+	}
+}
+
 // checkCollisions checks the player's block collisions.
 func (p *Player) checkBlockCollisions(vel mgl64.Vec3, w *world.World) {
 	entityBBox := p.Type().BBox(p).Translate(p.Position())
@@ -2673,6 +3124,68 @@ func (p *Player) TurnLecternPage(pos cube.Pos, page int) error {
 	return nil
 }
 
+// OpenTrading opens the trading window of the villager passed. OpenTrading does nothing if the entity passed is
+// not a villager or if the player has no session connected to it.
+func (p *Player) OpenTrading(e world.Entity) {
+	if p.session() != session.Nop {
+		p.session().OpenTrading(e)
+	}
+}
+
+// Trade makes the player use the trade with the index passed of the villager passed. The items paid for the
+// trade are not removed from the player. An error is returned if the trade could not be used.
+func (p *Player) Trade(e world.Entity, index int) error {
+	m, ok := e.(*entity.Mob)
+	if !ok {
+		return fmt.Errorf("trade: entity %v is not a villager", e.Type().EncodeEntity())
+	}
+	v, ok := m.Behaviour().(*entity.VillagerBehaviour)
+	if !ok {
+		return fmt.Errorf("trade: entity %v is not a villager", e.Type().EncodeEntity())
+	}
+	trades := v.Trades()
+	if index < 0 || index >= len(trades) || trades[index].Disabled() {
+		return fmt.Errorf("trade: trade %v is not available", index)
+	}
+
+	ctx := event.C()
+	if p.Handler().HandleTrade(ctx, e, trades[index]); ctx.Cancelled() {
+		return fmt.Errorf("trade: cancelled")
+	}
+	v.UseTrade(m, index)
+	return nil
+}
+
+// CastFishingHook sets the fishing hook passed as the hook cast by the player. The hook is reeled in when the player
+// uses a fishing rod again.
+func (p *Player) CastFishingHook(hook world.Entity) {
+	if e, ok := hook.(*entity.Ent); ok {
+		p.fishingHook.Store(e)
+	}
+}
+
+// ReelFishingHook reels in the fishing hook cast by the player. If a fish was biting the hook, it is caught and
+// launched towards the player. The durability lost by the fishing rod is returned, or false if the player had no
+// fishing hook cast.
+func (p *Player) ReelFishingHook() (int, bool) {
+	hook := p.fishingHook.Swap(nil)
+	if hook == nil || hook.World() == nil {
+		return 0, false
+	}
+	b, ok := hook.Behaviour().(*entity.FishingHookBehaviour)
+	if !ok {
+		return 0, false
+	}
+	it, xp, caught := b.Catch()
+	if caught {
+		ctx := event.C()
+		if p.Handler().HandleFish(ctx, hook, &it, &xp); ctx.Cancelled() {
+			it, xp = item.Stack{}, 0
+		}
+	}
+	return b.Reel(hook, it, xp), true
+}
+
 // updateState updates the state of the player to all viewers of the player.
 func (p *Player) updateState() {
 	for _, v := range p.viewers() {
@@ -2808,6 +3321,10 @@ func (p *Player) close(msg string) {
 		p.Respawn()
 	}
 	p.h.Swap(NopHandler{}).HandleQuit()
+	p.Wake()
+	if v, ok := p.RidingEntity(); ok {
+		p.dismount(v)
+	}
 
 	if s := p.s.Swap(nil); s != nil {
 		s.Disconnect(msg)
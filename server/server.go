@@ -475,6 +475,7 @@ func (srv *Server) createWorld(dim world.Dimension, nether, end **world.World) *
 		RandomTickSpeed: srv.conf.RandomTickSpeed,
 		ReadOnly:        srv.conf.ReadOnlyWorld,
 		Entities:        srv.conf.Entities,
+		Spawns:          srv.conf.Spawns,
 		PortalDestination: func(dim world.Dimension) *world.World {
 			if dim == world.Nether {
 				return *nether
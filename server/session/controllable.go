@@ -3,6 +3,7 @@ package session
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
@@ -62,6 +63,9 @@ type Controllable interface {
 	StartSneaking()
 	Sneaking() bool
 	StopSneaking()
+	StartBlocking()
+	Blocking() bool
+	StopBlocking()
 	StartSprinting()
 	Sprinting() bool
 	StopSprinting()
@@ -76,6 +80,11 @@ type Controllable interface {
 	StopGliding()
 	Jump()
 
+	RidingEntity() (entity.Rideable, bool)
+	DismountEntity()
+	SteerVehicle(input entity.RiderInput)
+	Wake()
+
 	StartBreaking(pos cube.Pos, face cube.Face)
 	ContinueBreaking(face cube.Face)
 	FinishBreaking()
@@ -86,6 +95,7 @@ type Controllable interface {
 	OpenSign(pos cube.Pos, frontSide bool)
 	EditSign(pos cube.Pos, frontText, backText string) error
 	TurnLecternPage(pos cube.Pos, page int) error
+	Trade(e world.Entity, index int) error
 
 	EnderChestInventory() *inventory.Inventory
 
@@ -1,10 +1,13 @@
 package session
 
 import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/item/potion"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
@@ -37,6 +40,9 @@ func (s *Session) parseEntityMetadata(e world.Entity) protocol.EntityMetadata {
 	if ent, ok := e.(*entity.Ent); ok {
 		s.addSpecificMetadata(ent.Behaviour(), m)
 	}
+	if mob, ok := e.(*entity.Mob); ok && mob.Behaviour() != nil {
+		s.addSpecificMetadata(mob.Behaviour(), m)
+	}
 	return m
 }
 
@@ -53,6 +59,9 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 	if gl, ok := e.(glider); ok && gl.Gliding() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagGliding)
 	}
+	if bl, ok := e.(blocker); ok && bl.Blocking() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagBlocking)
+	}
 	if b, ok := e.(breather); ok {
 		m[protocol.EntityDataKeyAirSupply] = int16(b.AirSupply().Milliseconds() / 50)
 		m[protocol.EntityDataKeyAirSupplyMax] = int16(b.MaxAirSupply().Milliseconds() / 50)
@@ -94,10 +103,15 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 	} else if o, ok := e.(owned); ok {
 		m[protocol.EntityDataKeyOwner] = int64(s.entityRuntimeID(o.Owner()))
 	}
+	if h, ok := e.(hooker); ok {
+		if hooked, ok := h.Hooked(); ok {
+			m[protocol.EntityDataKeyTarget] = int64(s.entityRuntimeID(hooked))
+		}
+	}
 	if sc, ok := e.(scaled); ok {
 		m[protocol.EntityDataKeyScale] = float32(sc.Scale())
 	}
-	if t, ok := e.(tnt); ok {
+	if t, ok := e.(tnt); ok && t.Fuse() >= 0 {
 		m[protocol.EntityDataKeyFuseTime] = int32(t.Fuse().Milliseconds() / 50)
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagIgnited)
 	}
@@ -158,18 +172,98 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 			}
 		}
 	}
+	if r, ok := e.(entity.Rider); ok {
+		if v, ok := r.RidingEntity(); ok {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagRiding)
+			m[protocol.EntityDataKeySeatOffset] = vec64To32(v.SeatOffset(r))
+		}
+	}
+	if sl, ok := e.(sleeper); ok {
+		if pos, ok := sl.Sleeping(); ok {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSleeping)
+			m.SetFlag(protocol.EntityDataKeyPlayerFlags, playerFlagSleeping)
+			m[protocol.EntityDataKeyBedPosition] = protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
+		}
+	}
+	if d, ok := e.(displayer); ok {
+		if b, ok := d.DisplayBlock(); ok {
+			m[protocol.EntityDataKeyDisplayTileRuntimeID] = int32(world.BlockRuntimeID(b))
+			m[protocol.EntityDataKeyDisplayOffset] = int32(6)
+			m[protocol.EntityDataKeyCustomDisplay] = byte(1)
+		}
+	}
+	if b, ok := e.(baby); ok && b.Baby() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagBaby)
+	}
+	if l, ok := e.(lover); ok && l.InLove() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagInLove)
+	}
+	if sh, ok := e.(shearable); ok && sh.Sheared() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSheared)
+	}
+	if c, ok := e.(coloured); ok {
+		m[protocol.EntityDataKeyColorIndex] = c.Colour().Uint8()
+	}
+	if p, ok := e.(patterned); ok {
+		m[protocol.EntityDataKeyColorTwoIndex] = p.PatternColour().Uint8()
+	}
 	if v, ok := e.(variable); ok {
 		m[protocol.EntityDataKeyVariant] = v.Variant()
 	}
 	if mv, ok := e.(markVariable); ok {
 		m[protocol.EntityDataKeyMarkVariant] = mv.MarkVariant()
 	}
+	if t, ok := e.(tameable); ok && t.Tamed() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagTamed)
+		if owner, ok := t.Owner(); ok {
+			m[protocol.EntityDataKeyOwner] = int64(s.entityRuntimeID(owner))
+		}
+		if t.Sitting() {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSitting)
+		}
+	}
+	if p, ok := e.(puffable); ok {
+		m[protocol.EntityDataKeyPuffedState] = uint8(p.PuffState())
+	}
+	if a, ok := e.(angry); ok && a.Angry() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagAngry)
+	}
+	if v, ok := e.(villager); ok {
+		m[protocol.EntityDataKeyVariant] = int32(v.Profession().Uint8())
+		m[protocol.EntityDataKeyTradeTier] = int32(v.Level() - 1)
+		m[protocol.EntityDataKeyMaxTradeTier] = int32(4)
+		m[protocol.EntityDataKeyTradeExperience] = int32(v.TradeExperience())
+	}
 }
 
 type sneaker interface {
 	Sneaking() bool
 }
 
+// playerFlagSleeping is the index of the flag in protocol.EntityDataKeyPlayerFlags that is set while a player
+// is sleeping.
+const playerFlagSleeping = 1
+
+type sleeper interface {
+	Sleeping() (cube.Pos, bool)
+}
+
+type baby interface {
+	Baby() bool
+}
+
+type lover interface {
+	InLove() bool
+}
+
+type shearable interface {
+	Sheared() bool
+}
+
+type coloured interface {
+	Colour() item.Colour
+}
+
 type sprinter interface {
 	Sprinting() bool
 }
@@ -182,6 +276,10 @@ type glider interface {
 	Gliding() bool
 }
 
+type blocker interface {
+	Blocking() bool
+}
+
 type breather interface {
 	Breathing() bool
 	AirSupply() time.Duration
@@ -204,6 +302,10 @@ type owned interface {
 	Owner() world.Entity
 }
 
+type hooker interface {
+	Hooked() (world.Entity, bool)
+}
+
 type named interface {
 	NameTag() string
 }
@@ -258,10 +360,28 @@ type tnt interface {
 	Fuse() time.Duration
 }
 
+type displayer interface {
+	DisplayBlock() (world.Block, bool)
+}
+
+type entityContainer interface {
+	Inventory() *inventory.Inventory
+	AddViewer(v block.ContainerViewer)
+	RemoveViewer(v block.ContainerViewer)
+}
+
 type living interface {
 	DeathPosition() (mgl64.Vec3, world.Dimension, bool)
 }
 
+type patterned interface {
+	PatternColour() item.Colour
+}
+
+type puffable interface {
+	PuffState() int
+}
+
 type variable interface {
 	Variant() int32
 }
@@ -269,3 +389,19 @@ type variable interface {
 type markVariable interface {
 	MarkVariant() int32
 }
+
+type tameable interface {
+	Tamed() bool
+	Sitting() bool
+	Owner() (world.Entity, bool)
+}
+
+type angry interface {
+	Angry() bool
+}
+
+type villager interface {
+	Profession() entity.VillagerProfession
+	Level() int
+	TradeExperience() int
+}
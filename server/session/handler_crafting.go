@@ -2,6 +2,7 @@ package session
 
 import (
 	"fmt"
+	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/creative"
 	"github.com/df-mc/dragonfly/server/item/inventory"
@@ -30,6 +31,7 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 	size := s.craftingSize()
 	offset := s.craftingOffset()
 	consumed := make([]bool, size)
+	inputs := make([]item.Stack, 0, len(craft.Input()))
 	for _, expected := range craft.Input() {
 		var processed bool
 		for slot := offset; slot < offset+size; slot++ {
@@ -47,6 +49,7 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 				continue
 			}
 			processed, consumed[slot-offset] = true, true
+			inputs = append(inputs, has)
 			st := has.Grow(-expected.Count())
 			h.setItemInSlot(protocol.StackRequestSlotInfo{
 				ContainerID: protocol.ContainerCraftingInput,
@@ -58,7 +61,26 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 			return fmt.Errorf("recipe %v: could not consume expected item: %v", a.RecipeNetworkID, expected)
 		}
 	}
-	return h.createResults(s, craft.Output()...)
+	return h.createResults(s, craftOutput(craft, inputs)...)
+}
+
+// craftOutput returns the output of the recipe passed when crafted using the input stacks passed. The output of most
+// recipes is fixed, but a shield crafted with a banner is decorated with the banner and otherwise keeps the properties
+// of the shield used, such as its durability and enchantments.
+func craftOutput(craft recipe.Recipe, inputs []item.Stack) []item.Stack {
+	var shield, banner item.Stack
+	for _, in := range inputs {
+		switch in.Item().(type) {
+		case item.Shield:
+			shield = in
+		case block.Banner:
+			banner = in
+		}
+	}
+	if shield.Empty() || banner.Empty() {
+		return craft.Output()
+	}
+	return []item.Stack{duplicateStack(shield.Grow(1-shield.Count()), item.Shield{Banner: banner.Item()})}
 }
 
 // handleAutoCraft handles the AutoCraftRecipe request action.
@@ -75,6 +97,13 @@ func (h *ItemStackRequestHandler) handleAutoCraft(a *protocol.AutoCraftRecipeSta
 	if craft.Block() != "crafting_table" {
 		return fmt.Errorf("recipe with network id %v is not a crafting table recipe", a.RecipeNetworkID)
 	}
+	for _, i := range craft.Input() {
+		if st, ok := i.(item.Stack); ok {
+			if _, ok := st.Item().(item.Shield); ok {
+				return fmt.Errorf("recipe with network id %v decorates a shield, which cannot be auto crafted", a.RecipeNetworkID)
+			}
+		}
+	}
 
 	repetitions := int(a.TimesCrafted)
 	input := make([]recipe.Item, 0, len(craft.Input()))
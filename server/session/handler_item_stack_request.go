@@ -6,6 +6,7 @@ import (
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
 	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
@@ -88,6 +89,10 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 		case *protocol.BeaconPaymentStackRequestAction:
 			err = h.handleBeaconPayment(a, s)
 		case *protocol.CraftRecipeStackRequestAction:
+			if m, v, ok := s.openedVillager(); ok {
+				err = h.handleTrade(a, s, m, v)
+				break
+			}
 			if s.containerOpened.Load() {
 				var special bool
 				switch s.c.World().Block(s.openedPos.Load()).(type) {
@@ -146,6 +151,9 @@ func (h *ItemStackRequestHandler) handleTransfer(from, to protocol.StackRequestS
 	if err := h.verifySlots(s, from, to); err != nil {
 		return fmt.Errorf("source slot out of sync: %w", err)
 	}
+	if err := h.verifyBinding(from, s); err != nil {
+		return err
+	}
 	i, _ := h.itemInSlot(from, s)
 	dest, _ := h.itemInSlot(to, s)
 	if !i.Comparable(dest) {
@@ -182,6 +190,12 @@ func (h *ItemStackRequestHandler) handleSwap(a *protocol.SwapStackRequestAction,
 	if err := h.verifySlots(s, a.Source, a.Destination); err != nil {
 		return fmt.Errorf("slot out of sync: %w", err)
 	}
+	if err := h.verifyBinding(a.Source, s); err != nil {
+		return err
+	}
+	if err := h.verifyBinding(a.Destination, s); err != nil {
+		return err
+	}
 	i, _ := h.itemInSlot(a.Source, s)
 	dest, _ := h.itemInSlot(a.Destination, s)
 
@@ -244,6 +258,9 @@ func (h *ItemStackRequestHandler) handleDrop(a *protocol.DropStackRequestAction,
 	if err := h.verifySlot(a.Source, s); err != nil {
 		return fmt.Errorf("source slot out of sync: %w", err)
 	}
+	if err := h.verifyBinding(a.Source, s); err != nil {
+		return err
+	}
 	i, _ := h.itemInSlot(a.Source, s)
 	if i.Count() < int(a.Count) {
 		return fmt.Errorf("client attempted to drop %v items, but only %v present", a.Count, i.Count())
@@ -348,6 +365,19 @@ func (h *ItemStackRequestHandler) verifySlot(slot protocol.StackRequestSlotInfo,
 	return nil
 }
 
+// verifyBinding checks if the item in the slot passed may be taken out of it. Armour enchanted with Curse of Binding
+// cannot be taken out of an armour slot unless the player has a creative inventory.
+func (h *ItemStackRequestHandler) verifyBinding(slot protocol.StackRequestSlotInfo, s *Session) error {
+	if slot.ContainerID != protocol.ContainerArmor || s.c.GameMode().CreativeInventory() {
+		return nil
+	}
+	i, _ := h.itemInSlot(slot, s)
+	if _, ok := i.Enchantment(enchantment.CurseOfBinding{}); ok {
+		return fmt.Errorf("client tried taking %v out of an armour slot, but it has curse of binding", i)
+	}
+	return nil
+}
+
 // resolveID resolves the stack network ID in the slot passed. If it is negative, it points to an earlier
 // request, in which case it will look it up in the changes of an earlier response to a request to find the
 // actual stack network ID in the slot. If it is positive, the ID will be returned again.
@@ -3,6 +3,7 @@ package session
 import (
 	"fmt"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
@@ -19,9 +20,24 @@ func (h PlayerAuthInputHandler) Handle(p packet.Packet, s *Session) error {
 	if err := h.handleMovement(pk, s); err != nil {
 		return err
 	}
+	h.handleRiderInput(pk, s)
 	return h.handleActions(pk, s)
 }
 
+// handleRiderInput forwards the movement input in the packet.PlayerAuthInput to the entity that the player is
+// riding, if any.
+func (h PlayerAuthInputHandler) handleRiderInput(pk *packet.PlayerAuthInput, s *Session) {
+	if _, ok := s.c.RidingEntity(); !ok {
+		return
+	}
+	s.c.SteerVehicle(entity.RiderInput{
+		Forward:  float64(pk.MoveVector[1]),
+		Sideways: float64(pk.MoveVector[0]),
+		Rotation: cube.Rotation{float64(pk.Yaw), float64(pk.Pitch)},
+		Jumping:  pk.InputData&packet.InputFlagJumping != 0,
+	})
+}
+
 // handleMovement handles the movement part of the packet.PlayerAuthInput.
 func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Session) error {
 	yaw, pitch := s.c.Rotation().Elem()
@@ -103,9 +119,14 @@ func (h PlayerAuthInputHandler) handleInputFlags(flags uint64, s *Session) {
 	}
 	if flags&packet.InputFlagStartSneaking != 0 {
 		s.c.StartSneaking()
+		if s.c.Sneaking() {
+			// Players raise a shield, if they hold one, while sneaking.
+			s.c.StartBlocking()
+		}
 	}
 	if flags&packet.InputFlagStopSneaking != 0 {
 		s.c.StopSneaking()
+		s.c.StopBlocking()
 	}
 	if flags&packet.InputFlagStartSwimming != 0 {
 		s.c.StartSwimming()
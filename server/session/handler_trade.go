@@ -0,0 +1,126 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+const (
+	// tradeInputSlot is the slot index of the first input item in the trading
+	// window.
+	tradeInputSlot = 0x04
+	// tradeSecondInputSlot is the slot index of the second input item in the
+	// trading window.
+	tradeSecondInputSlot = 0x05
+)
+
+// handleTrade handles a CraftRecipe stack request action made using the trading window of a villager.
+func (h *ItemStackRequestHandler) handleTrade(a *protocol.CraftRecipeStackRequestAction, s *Session, m *entity.Mob, v *entity.VillagerBehaviour) error {
+	index := s.tradeIndex(a.RecipeNetworkID)
+	trades := v.Trades()
+	if index < 0 || index >= len(trades) {
+		return fmt.Errorf("trade with network id %v does not exist", a.RecipeNetworkID)
+	}
+	t := trades[index]
+	inputSlot := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientOne, Slot: tradeInputSlot}
+	secondInputSlot := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientTwo, Slot: tradeSecondInputSlot}
+
+	input, _ := h.itemInSlot(inputSlot, s)
+	secondInput, _ := h.itemInSlot(secondInputSlot, s)
+	price := t.Price()
+	if !input.Comparable(price) || input.Count() < price.Count() {
+		return fmt.Errorf("first input item is not the same as expected input")
+	}
+	if !t.SecondInput.Empty() && (!secondInput.Comparable(t.SecondInput) || secondInput.Count() < t.SecondInput.Count()) {
+		return fmt.Errorf("second input item is not the same as expected input")
+	}
+	if err := s.c.Trade(m, index); err != nil {
+		return err
+	}
+	input = input.Grow(-price.Count())
+	if !t.SecondInput.Empty() {
+		secondInput = secondInput.Grow(-t.SecondInput.Count())
+	}
+	h.setItemInSlot(inputSlot, input, s)
+	h.setItemInSlot(secondInputSlot, secondInput, s)
+	s.sendTrades(m, v, byte(s.openedWindowID.Load()))
+	return h.createResults(s, t.Output)
+}
+
+// openedVillager returns the villager whose trading window is currently opened by the Session. False is
+// returned if no trading window is opened.
+func (s *Session) openedVillager() (*entity.Mob, *entity.VillagerBehaviour, bool) {
+	if !s.containerOpened.Load() {
+		return nil, nil, false
+	}
+	m, ok := s.openedEntity.Load().(*entity.Mob)
+	if !ok {
+		return nil, nil, false
+	}
+	v, ok := m.Behaviour().(*entity.VillagerBehaviour)
+	return m, v, ok
+}
+
+// tradeIndex returns the index of the trade with the network ID passed. Network IDs of trades follow those
+// of the crafting recipes sent to the client.
+func (s *Session) tradeIndex(networkID uint32) int {
+	return int(networkID) - len(s.recipes) - 1
+}
+
+// sendTrades sends the trades of a villager to the client in an UpdateTrade packet, opening or updating the
+// trading window with the window ID passed.
+func (s *Session) sendTrades(m *entity.Mob, v *entity.VillagerBehaviour, windowID byte) {
+	trades := v.Trades()
+	recipes := make([]map[string]any, 0, len(trades))
+	for i, t := range trades {
+		price := t.Price()
+		recipe := map[string]any{
+			"buyA":             nbtconv.WriteItem(price, true),
+			"buyCountA":        int32(price.Count()),
+			"sell":             nbtconv.WriteItem(t.Output, true),
+			"uses":             int32(t.Uses),
+			"maxUses":          int32(t.MaxUses),
+			"tier":             int32(max(t.Tier-1, 0)),
+			"traderExp":        int32(t.Experience),
+			"rewardExp":        byte(1),
+			"priceMultiplierA": float32(t.PriceMultiplier),
+			"demand":           int32(0),
+			"netId":            int32(len(s.recipes) + 1 + i),
+		}
+		if !t.SecondInput.Empty() {
+			recipe["buyB"] = nbtconv.WriteItem(t.SecondInput, true)
+			recipe["buyCountB"] = int32(t.SecondInput.Count())
+		}
+		recipes = append(recipes, recipe)
+	}
+	levels := v.LevelExperience()
+	requirements := make([]map[string]any, 0, len(levels))
+	for i, xp := range levels {
+		requirements = append(requirements, map[string]any{fmt.Sprint(i): int32(xp)})
+	}
+	offers, err := nbt.MarshalEncoding(map[string]any{
+		"Recipes":             recipes,
+		"TierExpRequirements": requirements,
+	}, nbt.NetworkLittleEndian)
+	if err != nil {
+		s.log.Errorf("encode trades: %v", err)
+		return
+	}
+	s.writePacket(&packet.UpdateTrade{
+		WindowID:          windowID,
+		WindowType:        protocol.ContainerTypeTrade,
+		Size:              int32(len(trades)),
+		TradeTier:         int32(v.Level() - 1),
+		VillagerUniqueID:  int64(s.entityRuntimeID(m)),
+		EntityUniqueID:    selfEntityRuntimeID,
+		DisplayName:       v.Profession().Name(),
+		NewTradeUI:        true,
+		DemandBasedPrices: false,
+		SerialisedOffers:  offers,
+	})
+}
@@ -52,6 +52,19 @@ func (s *Session) closeCurrentContainer() {
 	}
 	s.closeWindow()
 
+	if e := s.openedEntity.Load(); e != nil {
+		s.openedEntity.Store(nil)
+		if ent, ok := e.(*entity.Ent); ok {
+			if c, ok := ent.Behaviour().(entityContainer); ok {
+				c.RemoveViewer(s)
+			}
+		} else if m, ok := e.(*entity.Mob); ok {
+			if v, ok := m.Behaviour().(*entity.VillagerBehaviour); ok {
+				v.StopTrading(s.c)
+			}
+		}
+		return
+	}
 	pos := s.openedPos.Load()
 	w := s.c.World()
 	b := w.Block(pos)
@@ -94,6 +107,10 @@ func (s *Session) SendRespawn(pos mgl64.Vec3) {
 // sendRecipes sends the current crafting recipes to the session.
 func (s *Session) sendRecipes() {
 	recipes := make([]protocol.Recipe, 0, len(recipe.Recipes()))
+	var (
+		potionRecipes    []protocol.PotionRecipe
+		containerRecipes []protocol.PotionContainerChangeRecipe
+	)
 	for index, i := range recipe.Recipes() {
 		networkID := uint32(index) + 1
 		s.recipes[networkID] = i
@@ -140,9 +157,35 @@ func (s *Session) sendRecipes() {
 				Block:           i.Block(),
 				RecipeNetworkID: networkID,
 			})
+		case recipe.Potion:
+			input, inputMeta := brewingItemID(i.Input()[0])
+			reagent, reagentMeta := brewingItemID(i.Input()[1])
+			output, outputMeta := brewingItemID(i.Output()[0])
+			potionRecipes = append(potionRecipes, protocol.PotionRecipe{
+				InputPotionID:        input,
+				InputPotionMetadata:  inputMeta,
+				ReagentItemID:        reagent,
+				ReagentItemMetadata:  reagentMeta,
+				OutputPotionID:       output,
+				OutputPotionMetadata: outputMeta,
+			})
+		case recipe.PotionContainerChange:
+			input, _ := brewingItemID(i.Input()[0])
+			reagent, _ := brewingItemID(i.Input()[1])
+			output, _ := brewingItemID(i.Output()[0])
+			containerRecipes = append(containerRecipes, protocol.PotionContainerChangeRecipe{
+				InputItemID:   input,
+				ReagentItemID: reagent,
+				OutputItemID:  output,
+			})
 		}
 	}
-	s.writePacket(&packet.CraftingData{Recipes: recipes, ClearRecipes: true})
+	s.writePacket(&packet.CraftingData{
+		Recipes:                      recipes,
+		PotionRecipes:                potionRecipes,
+		PotionContainerChangeRecipes: containerRecipes,
+		ClearRecipes:                 true,
+	})
 }
 
 // sendArmourTrimData sends the armour trim data.
@@ -227,12 +270,21 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 		// Armour inventory.
 		return s.armour.Inventory(), true
 	case protocol.ContainerLevelEntity:
+		if s.containerOpened.Load() && s.openedEntity.Load() != nil {
+			return s.openedWindow.Load(), true
+		}
 		if s.containerOpened.Load() {
 			b := s.c.World().Block(s.openedPos.Load())
 			if _, chest := b.(block.Chest); chest {
 				return s.openedWindow.Load(), true
 			} else if _, enderChest := b.(block.EnderChest); enderChest {
 				return s.openedWindow.Load(), true
+			} else if _, hopper := b.(block.Hopper); hopper {
+				return s.openedWindow.Load(), true
+			} else if _, dispenser := b.(block.Dispenser); dispenser {
+				return s.openedWindow.Load(), true
+			} else if _, dropper := b.(block.Dropper); dropper {
+				return s.openedWindow.Load(), true
 			}
 		}
 	case protocol.ContainerBarrel:
@@ -241,6 +293,12 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.openedWindow.Load(), true
 			}
 		}
+	case protocol.ContainerShulkerBox:
+		if s.containerOpened.Load() {
+			if _, shulkerBox := s.c.World().Block(s.openedPos.Load()).(block.ShulkerBox); shulkerBox {
+				return s.openedWindow.Load(), true
+			}
+		}
 	case protocol.ContainerBeaconPayment:
 		if s.containerOpened.Load() {
 			if _, beacon := s.c.World().Block(s.openedPos.Load()).(block.Beacon); beacon {
@@ -283,6 +341,16 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.ui, true
 			}
 		}
+	case protocol.ContainerTradeTwoIngredientOne, protocol.ContainerTradeTwoIngredientTwo:
+		if _, _, ok := s.openedVillager(); ok {
+			return s.ui, true
+		}
+	case protocol.ContainerBrewingStandInput, protocol.ContainerBrewingStandResult, protocol.ContainerBrewingStandFuel:
+		if s.containerOpened.Load() {
+			if _, brewingStand := s.c.World().Block(s.openedPos.Load()).(block.BrewingStand); brewingStand {
+				return s.openedWindow.Load(), true
+			}
+		}
 	case protocol.ContainerFurnaceIngredient, protocol.ContainerFurnaceFuel, protocol.ContainerFurnaceResult,
 		protocol.ContainerBlastFurnaceIngredient, protocol.ContainerSmokerIngredient:
 		if s.containerOpened.Load() {
@@ -805,6 +873,17 @@ func stacksToRecipeStacks(inputs []item.Stack) []protocol.ItemStack {
 	return items
 }
 
+// brewingItemID returns the network ID and metadata of the item of a brewing recipe passed. Brewing recipes only hold
+// item.Stacks, so 0 is returned for other recipe items.
+func brewingItemID(i recipe.Item) (id, meta int32) {
+	s, ok := i.(item.Stack)
+	if !ok || s.Empty() {
+		return 0, 0
+	}
+	rid, m, _ := world.ItemRuntimeID(s.Item())
+	return rid, int32(m)
+}
+
 // stacksToIngredientItems converts a list of item.Stacks to recipe ingredient items used over the network.
 func stacksToIngredientItems(inputs []recipe.Item) []protocol.ItemDescriptorCount {
 	items := make([]protocol.ItemDescriptorCount, 0, len(inputs))
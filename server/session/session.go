@@ -71,6 +71,7 @@ type Session struct {
 	openedContainerID              atomic.Uint32
 	openedWindow                   atomic.Value[*inventory.Inventory]
 	openedPos                      atomic.Value[cube.Pos]
+	openedEntity                   atomic.Value[world.Entity]
 	swingingArm                    atomic.Bool
 
 	recipes map[uint32]recipe.Recipe
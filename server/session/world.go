@@ -3,6 +3,7 @@ package session
 import (
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"image/color"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
@@ -105,6 +106,7 @@ func (s *Session) ViewEntity(e world.Entity) {
 			UUID:            v.UUID(),
 			Username:        v.Name(),
 			Yaw:             float32(yaw),
+			EntityLinks:     s.entityLinks(e),
 			AbilityData: protocol.AbilityData{
 				EntityUniqueID: int64(runtimeID),
 				Layers: []protocol.AbilityLayer{{
@@ -131,6 +133,9 @@ func (s *Session) ViewEntity(e world.Entity) {
 				EntityMetadata:  metadata,
 			})
 			return
+		case entity.NPCType:
+			s.viewNPC(v, runtimeID, metadata)
+			return
 		case entity.TextType:
 			metadata[protocol.EntityDataKeyVariant] = int32(world.BlockRuntimeID(block.Air{}))
 		case entity.FallingBlockType:
@@ -146,19 +151,134 @@ func (s *Session) ViewEntity(e world.Entity) {
 		vel = v.Velocity()
 	}
 
+	var attributes []protocol.AttributeValue
+	for _, a := range entityAttributes(e) {
+		attributes = append(attributes, a.AttributeValue)
+	}
+
 	s.writePacket(&packet.AddActor{
 		EntityUniqueID:  int64(runtimeID),
 		EntityRuntimeID: runtimeID,
 		EntityType:      id,
 		EntityMetadata:  metadata,
+		Attributes:      attributes,
 		Position:        vec64To32(e.Position()),
 		Velocity:        vec64To32(vel),
 		Pitch:           float32(pitch),
 		Yaw:             float32(yaw),
 		HeadYaw:         float32(yaw),
+		EntityLinks:     s.entityLinks(e),
+	})
+}
+
+// viewNPC shows a human NPC to the session. NPCs are added as players, but are removed from the player list
+// directly after so that they only remain visible in the world.
+func (s *Session) viewNPC(e *entity.Ent, runtimeID uint64, metadata protocol.EntityMetadata) {
+	n := e.Behaviour().(*entity.NPCBehaviour)
+	yaw, pitch := e.Rotation().Elem()
+
+	s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionAdd, Entries: []protocol.PlayerListEntry{{
+		UUID:           n.UUID(),
+		EntityUniqueID: int64(runtimeID),
+		Username:       e.NameTag(),
+		Skin:           skinToProtocol(n.Skin()),
+	}}})
+	s.writePacket(&packet.AddPlayer{
+		EntityMetadata:  metadata,
+		EntityRuntimeID: runtimeID,
+		GameType:        packet.GameTypeSurvival,
+		HeadYaw:         float32(yaw),
+		Pitch:           float32(pitch),
+		Position:        vec64To32(e.Position()),
+		UUID:            n.UUID(),
+		Username:        e.NameTag(),
+		Yaw:             float32(yaw),
+		AbilityData: protocol.AbilityData{
+			EntityUniqueID: int64(runtimeID),
+			Layers: []protocol.AbilityLayer{{
+				Type:      protocol.AbilityLayerTypeBase,
+				Abilities: protocol.AbilityCount - 1,
+			}},
+		},
+	})
+	// The client only loads the skin of the NPC while it is in the player list, so the entry is removed only after
+	// some time has passed, similar to vanilla.
+	time.AfterFunc(npcPlayerListDelay, func() {
+		s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionRemove, Entries: []protocol.PlayerListEntry{{
+			UUID: n.UUID(),
+		}}})
 	})
 }
 
+// npcPlayerListDelay is the time after which an NPC shown to a session is removed from its player list again.
+const npcPlayerListDelay = time.Second
+
+// entityAttributes returns the attributes of a living entity that is not a player, such as its health. Nil is
+// returned if the entity is not living.
+func entityAttributes(e world.Entity) []protocol.Attribute {
+	l, ok := e.(entity.Living)
+	if _, controllable := e.(Controllable); !ok || controllable {
+		return nil
+	}
+	return []protocol.Attribute{
+		{
+			AttributeValue: protocol.AttributeValue{
+				Name:  "minecraft:health",
+				Value: float32(math.Ceil(l.Health())),
+				Max:   float32(math.Ceil(l.MaxHealth())),
+			},
+			Default: float32(math.Ceil(l.MaxHealth())),
+		},
+		{
+			AttributeValue: protocol.AttributeValue{
+				Name:  "minecraft:movement",
+				Value: float32(l.Speed()),
+				Max:   math.MaxFloat32,
+			},
+			Default: float32(l.Speed()),
+		},
+	}
+}
+
+// entityLinks returns the links between the entity passed and the entities it
+// is riding or being ridden by, provided the session is viewing those
+// entities.
+func (s *Session) entityLinks(e world.Entity) (links []protocol.EntityLink) {
+	if r, ok := e.(entity.Rider); ok {
+		if v, ok := r.RidingEntity(); ok && s.entityRuntimeID(v) != 0 {
+			links = append(links, s.entityLink(r, v, passengerLinkType(r, v)))
+		}
+	}
+	if v, ok := e.(entity.Rideable); ok {
+		for _, r := range v.Passengers() {
+			if s.entityRuntimeID(r) != 0 {
+				links = append(links, s.entityLink(r, v, passengerLinkType(r, v)))
+			}
+		}
+	}
+	return links
+}
+
+// passengerLinkType returns the type of the link between the rider and the
+// vehicle passed. The first passenger of a vehicle drives it.
+func passengerLinkType(r entity.Rider, v entity.Rideable) byte {
+	if passengers := v.Passengers(); len(passengers) > 0 && passengers[0] == r {
+		return protocol.EntityLinkRider
+	}
+	return protocol.EntityLinkPassenger
+}
+
+// entityLink creates a protocol.EntityLink of the type passed between a rider
+// and the vehicle it is riding.
+func (s *Session) entityLink(rider, vehicle world.Entity, t byte) protocol.EntityLink {
+	return protocol.EntityLink{
+		RiddenEntityUniqueID: int64(s.entityRuntimeID(vehicle)),
+		RiderEntityUniqueID:  int64(s.entityRuntimeID(rider)),
+		Type:                 t,
+		RiderInitiated:       true,
+	}
+}
+
 // ViewEntityGameMode ...
 func (s *Session) ViewEntityGameMode(e world.Entity) {
 	if s.entityHidden(e) {
@@ -461,9 +581,52 @@ func (s *Session) ViewParticle(pos mgl64.Vec3, p world.Particle) {
 			EventType: packet.LevelEventParticleLegacyEvent | 10,
 			Position:  vec64To32(pos),
 		})
+	case particle.CampfireSmoke:
+		if pa.Tall {
+			s.writePacket(&packet.LevelEvent{
+				EventType: packet.LevelEventParticleLegacyEvent | 68,
+				Position:  vec64To32(pos),
+			})
+			return
+		}
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventParticleLegacyEvent | 67,
+			Position:  vec64To32(pos),
+		})
 	}
 }
 
+// cauldronLevelEvent converts a cauldron world.Sound to the level event used to play it.
+func cauldronLevelEvent(t world.Sound) int32 {
+	switch t.(type) {
+	case sound.CauldronFillWater:
+		return packet.LevelEventCauldronFillWater
+	case sound.CauldronTakeWater:
+		return packet.LevelEventCauldronTakeWater
+	case sound.CauldronFillLava:
+		return packet.LevelEventCauldronFillLava
+	case sound.CauldronTakeLava:
+		return packet.LevelEventCauldronTakeLava
+	case sound.CauldronFillPowderSnow:
+		return packet.LevelEventCauldronFillPowderSnow
+	case sound.CauldronTakePowderSnow:
+		return packet.LevelEventCauldronTakePowderSnow
+	case sound.CauldronFillPotion:
+		return packet.LevelEventCauldronFillPotion
+	case sound.CauldronTakePotion:
+		return packet.LevelEventCauldronTakePotion
+	case sound.CauldronAddDye:
+		return packet.LevelEventCauldronAddDye
+	case sound.CauldronDyeArmour:
+		return packet.LevelEventCauldronDyeArmor
+	case sound.CauldronCleanArmour:
+		return packet.LevelEventCauldronCleanArmor
+	case sound.CauldronCleanBanner:
+		return packet.LevelEventCauldronCleanBanner
+	}
+	panic("should never happen")
+}
+
 // tierToSoundEvent converts an item.ArmourTier to a sound event associated with equipping it.
 func tierToSoundEvent(tier item.ArmourTier) uint32 {
 	switch tier.(type) {
@@ -526,6 +689,18 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 			Position:  vec64To32(pos),
 		})
 		return
+	case sound.ClickFail:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventSoundClickFail,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.Launch:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventSoundLaunch,
+			Position:  vec64To32(pos),
+		})
+		return
 	case sound.SignWaxed:
 		s.writePacket(&packet.LevelEvent{
 			EventType: packet.LevelEventWaxOn,
@@ -585,12 +760,22 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventBlast
 	case sound.FireworkTwinkle:
 		pk.SoundType = packet.SoundEventTwinkle
+	case sound.MilkCow:
+		pk.SoundType, pk.EntityType = packet.SoundEventMilk, "minecraft:cow"
+	case sound.Shear:
+		pk.SoundType, pk.EntityType = packet.SoundEventShear, "minecraft:sheep"
+	case sound.Plop:
+		pk.SoundType, pk.EntityType = packet.SoundEventPlop, "minecraft:chicken"
+	case sound.Flop:
+		pk.SoundType, pk.EntityType = packet.SoundEventFlop, so.Entity.EncodeEntity()
 	case sound.FurnaceCrackle:
 		pk.SoundType = packet.SoundEventFurnaceUse
 	case sound.BlastFurnaceCrackle:
 		pk.SoundType = packet.SoundEventBlastFurnaceUse
 	case sound.SmokerCrackle:
 		pk.SoundType = packet.SoundEventSmokerUse
+	case sound.PotionBrewed:
+		pk.SoundType = packet.SoundEventPotionBrewed
 	case sound.UseSpyglass:
 		pk.SoundType = packet.SoundEventUseSpyglass
 	case sound.StopUsingSpyglass:
@@ -616,6 +801,8 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		}
 	case sound.FireExtinguish:
 		pk.SoundType = packet.SoundEventExtinguishFire
+	case sound.CampfireCrackle:
+		pk.SoundType = packet.SoundEventCampfireCrackle
 	case sound.Ignite:
 		pk.SoundType = packet.SoundEventIgnite
 	case sound.Burning:
@@ -677,6 +864,10 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventBarrelClose
 	case sound.BarrelOpen:
 		pk.SoundType = packet.SoundEventBarrelOpen
+	case sound.ShulkerBoxClose:
+		pk.SoundType = packet.SoundEventShulkerBoxClosed
+	case sound.ShulkerBoxOpen:
+		pk.SoundType = packet.SoundEventShulkerBoxOpen
 	case sound.BlockBreaking:
 		pk.SoundType, pk.ExtraData = packet.SoundEventHit, int32(world.BlockRuntimeID(so.Block))
 	case sound.ItemBreak:
@@ -704,12 +895,18 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 			break
 		}
 		pk.SoundType = packet.SoundEventBucketEmptyLava
+	case sound.BucketFillFish:
+		pk.SoundType = packet.SoundEventBucketFillFish
+	case sound.BucketEmptyFish:
+		pk.SoundType = packet.SoundEventBucketEmptyFish
 	case sound.BowShoot:
 		pk.SoundType = packet.SoundEventBow
 	case sound.ArrowHit:
 		pk.SoundType = packet.SoundEventBowHit
 	case sound.ItemThrow:
 		pk.SoundType, pk.EntityType = packet.SoundEventThrow, "minecraft:player"
+	case sound.ShieldBlock:
+		pk.SoundType = packet.SoundEventShieldBlock
 	case sound.LevelUp:
 		pk.SoundType, pk.ExtraData = packet.SoundEventLevelUp, 0x10000000
 	case sound.Experience:
@@ -771,6 +968,14 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventComposterReady
 	case sound.LecternBookPlace:
 		pk.SoundType = packet.SoundEventLecternBookPlace
+	case sound.CauldronFillWater, sound.CauldronTakeWater, sound.CauldronFillLava, sound.CauldronTakeLava,
+		sound.CauldronFillPowderSnow, sound.CauldronTakePowderSnow, sound.CauldronFillPotion, sound.CauldronTakePotion,
+		sound.CauldronAddDye, sound.CauldronDyeArmour, sound.CauldronCleanArmour, sound.CauldronCleanBanner:
+		s.writePacket(&packet.LevelEvent{
+			EventType: cauldronLevelEvent(t),
+			Position:  vec64To32(pos),
+		})
+		return
 	case sound.Totem:
 		s.writePacket(&packet.LevelEvent{
 			EventType: packet.LevelEventSoundTotemUsed,
@@ -830,6 +1035,33 @@ func (s *Session) ViewFurnaceUpdate(prevCookTime, cookTime, prevRemainingFuelTim
 	}
 }
 
+// ViewBrewingUpdate updates a brewing stand for the associated session based on previous brew times and fuel.
+func (s *Session) ViewBrewingUpdate(prevBrewTime, brewTime time.Duration, prevFuelAmount, fuelAmount, prevFuelTotal, fuelTotal int32) {
+	if prevBrewTime != brewTime {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandBrewTime,
+			Value:    int32(brewTime.Milliseconds() / 50),
+		})
+	}
+
+	if prevFuelAmount != fuelAmount {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandFuelAmount,
+			Value:    fuelAmount,
+		})
+	}
+
+	if prevFuelTotal != fuelTotal {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandFuelTotal,
+			Value:    fuelTotal,
+		})
+	}
+}
+
 // ViewBlockUpdate ...
 func (s *Session) ViewBlockUpdate(pos cube.Pos, b world.Block, layer int) {
 	blockPos := protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
@@ -867,6 +1099,16 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 			EntityRuntimeID: s.entityRuntimeID(e),
 			EventType:       packet.ActorEventStartAttacking,
 		})
+	case entity.LoveAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventLoveHearts,
+		})
+	case entity.EatGrassAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventEatGrass,
+		})
 	case entity.HurtAction:
 		s.writePacket(&packet.ActorEvent{
 			EntityRuntimeID: s.entityRuntimeID(e),
@@ -887,6 +1129,15 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 			ItemEntityRuntimeID:  s.entityRuntimeID(e),
 			TakerEntityRuntimeID: s.entityRuntimeID(act.Collector),
 		})
+	case entity.TameAction:
+		event := byte(packet.ActorEventTamingFailed)
+		if act.Success {
+			event = packet.ActorEventTamingSucceeded
+		}
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       event,
+		})
 	case entity.ArrowShakeAction:
 		s.writePacket(&packet.ActorEvent{
 			EntityRuntimeID: s.entityRuntimeID(e),
@@ -919,6 +1170,21 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 				EventData: (rid << 16) | int32(meta),
 			})
 		}
+	case entity.WakeUpAction:
+		s.writePacket(&packet.Animate{
+			ActionType:      packet.AnimateActionStopSleep,
+			EntityRuntimeID: s.entityRuntimeID(e),
+		})
+	case entity.FishingHookBubbleAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventFishhookBubble,
+		})
+	case entity.FishingHookBiteAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventFishhookHookTime,
+		})
 	case entity.TotemUseAction:
 		s.writePacket(&packet.ActorEvent{
 			EntityRuntimeID: s.entityRuntimeID(e),
@@ -933,6 +1199,12 @@ func (s *Session) ViewEntityState(e world.Entity) {
 		EntityRuntimeID: s.entityRuntimeID(e),
 		EntityMetadata:  s.parseEntityMetadata(e),
 	})
+	if attributes := entityAttributes(e); attributes != nil {
+		s.writePacket(&packet.UpdateAttributes{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			Attributes:      attributes,
+		})
+	}
 }
 
 // ViewEntityAnimation ...
@@ -945,9 +1217,29 @@ func (s *Session) ViewEntityAnimation(e world.Entity, animationName string) {
 	})
 }
 
+// ViewEntityMount ...
+func (s *Session) ViewEntityMount(rider, vehicle world.Entity, driver bool) {
+	if s.entityRuntimeID(rider) == 0 || s.entityRuntimeID(vehicle) == 0 {
+		return
+	}
+	t := byte(protocol.EntityLinkPassenger)
+	if driver {
+		t = protocol.EntityLinkRider
+	}
+	s.writePacket(&packet.SetActorLink{EntityLink: s.entityLink(rider, vehicle, t)})
+}
+
+// ViewEntityDismount ...
+func (s *Session) ViewEntityDismount(rider, vehicle world.Entity) {
+	if s.entityRuntimeID(rider) == 0 || s.entityRuntimeID(vehicle) == 0 {
+		return
+	}
+	s.writePacket(&packet.SetActorLink{EntityLink: s.entityLink(rider, vehicle, protocol.EntityLinkRemove)})
+}
+
 // OpenBlockContainer ...
 func (s *Session) OpenBlockContainer(pos cube.Pos) {
-	if s.containerOpened.Load() && s.openedPos.Load() == pos {
+	if s.containerOpened.Load() && s.openedEntity.Load() == nil && s.openedPos.Load() == pos {
 		return
 	}
 	s.closeCurrentContainer()
@@ -1000,6 +1292,67 @@ func (s *Session) OpenBlockContainer(pos cube.Pos) {
 	})
 }
 
+// OpenEntityContainer opens the inventory of an entity, such as a chest
+// minecart.
+func (s *Session) OpenEntityContainer(e world.Entity) {
+	if s.containerOpened.Load() && s.openedEntity.Load() == e {
+		return
+	}
+	ent, ok := e.(*entity.Ent)
+	if !ok {
+		return
+	}
+	c, ok := ent.Behaviour().(entityContainer)
+	if !ok || c.Inventory() == nil {
+		return
+	}
+	s.closeCurrentContainer()
+	c.AddViewer(s)
+
+	nextID := s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(c.Inventory())
+	s.openedEntity.Store(e)
+
+	containerType := byte(protocol.ContainerTypeCartChest)
+	switch e.Type().(type) {
+	case entity.HopperMinecartType:
+		containerType = protocol.ContainerTypeCartHopper
+	case entity.ChestBoatType:
+		containerType = protocol.ContainerTypeChestBoat
+	}
+	s.openedContainerID.Store(uint32(containerType))
+	s.writePacket(&packet.ContainerOpen{
+		WindowID:                nextID,
+		ContainerType:           containerType,
+		ContainerEntityUniqueID: int64(s.entityRuntimeID(e)),
+	})
+	s.sendInv(c.Inventory(), uint32(nextID))
+}
+
+// OpenTrading opens the trading window of a villager.
+func (s *Session) OpenTrading(e world.Entity) {
+	if s.containerOpened.Load() && s.openedEntity.Load() == e {
+		return
+	}
+	m, ok := e.(*entity.Mob)
+	if !ok {
+		return
+	}
+	v, ok := m.Behaviour().(*entity.VillagerBehaviour)
+	if !ok {
+		return
+	}
+	s.closeCurrentContainer()
+
+	nextID := s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(inventory.New(1, nil))
+	s.openedEntity.Store(e)
+	s.openedContainerID.Store(uint32(protocol.ContainerTypeTrade))
+	s.sendTrades(m, v, nextID)
+}
+
 // openNormalContainer opens a normal container that can hold items in it server-side.
 func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 	b.AddViewer(s, s.c.World(), pos)
@@ -1017,6 +1370,14 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 		containerType = protocol.ContainerTypeBlastFurnace
 	case block.Smoker:
 		containerType = protocol.ContainerTypeSmoker
+	case block.BrewingStand:
+		containerType = protocol.ContainerTypeBrewingStand
+	case block.Hopper:
+		containerType = protocol.ContainerTypeHopper
+	case block.Dispenser:
+		containerType = protocol.ContainerTypeDispenser
+	case block.Dropper:
+		containerType = protocol.ContainerTypeDropper
 	}
 
 	s.writePacket(&packet.ContainerOpen{
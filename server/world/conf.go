@@ -39,6 +39,9 @@ type Config struct {
 	// Entities is an EntityRegistry with all entity types registered that may
 	// be added to the World.
 	Entities EntityRegistry
+	// Spawns returns the SpawnEntries of mobs that may be spawned naturally
+	// in a Biome. If set to nil, no mobs are spawned naturally in the World.
+	Spawns func(b Biome) []SpawnEntry
 }
 
 // Logger is a logger implementation that may be passed to the Log field of Config. World will send errors and debug
@@ -114,10 +114,16 @@ type EntityRegistryConfig struct {
 	Egg                func(pos, vel mgl64.Vec3, owner Entity) Entity
 	EnderPearl         func(pos, vel mgl64.Vec3, owner Entity) Entity
 	Firework           func(pos mgl64.Vec3, rot cube.Rotation, attached bool, firework Item, owner Entity) Entity
+	FishingHook        func(pos, vel mgl64.Vec3, owner Entity, lure, luckOfTheSea int) Entity
 	LingeringPotion    func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Snowball           func(pos, vel mgl64.Vec3, owner Entity) Entity
 	SplashPotion       func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Lightning          func(pos mgl64.Vec3) Entity
+	Minecart           func(pos mgl64.Vec3) Entity
+	ChestMinecart      func(pos mgl64.Vec3) Entity
+	HopperMinecart     func(pos mgl64.Vec3) Entity
+	TNTMinecart        func(pos mgl64.Vec3) Entity
+	Boat               func(pos mgl64.Vec3, yaw float64, wood any, chest bool) Entity
 }
 
 // New creates an EntityRegistry using conf and the EntityTypes passed.
@@ -39,6 +39,9 @@ type Handler interface {
 	HandleEntitySpawn(e Entity)
 	// HandleEntityDespawn handles an entity being despawned from a World through a call to World.RemoveEntity.
 	HandleEntityDespawn(e Entity)
+	// HandleMobSpawn handles a mob being spawned naturally in the World. ctx.Cancel() may be called to prevent the mob
+	// from spawning. The Entity pointed to by e may be changed to spawn a different entity instead.
+	HandleMobSpawn(ctx *event.Context, e *Entity)
 	// HandleClose handles the World being closed. HandleClose may be used as a moment to finish code running on other
 	// goroutines that operates on the World specifically. HandleClose is called directly before the World stops
 	// ticking and before any chunks are saved to disk.
@@ -61,4 +64,5 @@ func (NopHandler) HandleFireSpread(*event.Context, cube.Pos, cube.Pos)
 func (NopHandler) HandleBlockBurn(*event.Context, cube.Pos)                           {}
 func (NopHandler) HandleEntitySpawn(Entity)                                           {}
 func (NopHandler) HandleEntityDespawn(Entity)                                         {}
+func (NopHandler) HandleMobSpawn(*event.Context, *Entity)                             {}
 func (NopHandler) HandleClose()                                                       {}
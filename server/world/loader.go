@@ -16,6 +16,7 @@ type Loader struct {
 
 	mu        sync.RWMutex
 	pos       ChunkPos
+	position  mgl64.Vec3
 	loadQueue []ChunkPos
 	loaded    map[ChunkPos]*Column
 
@@ -64,6 +65,7 @@ func (l *Loader) Move(pos mgl64.Vec3) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	l.position = pos
 	chunkPos := chunkPosFromVec3(pos)
 	if chunkPos == l.pos {
 		return
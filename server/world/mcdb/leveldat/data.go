@@ -197,6 +197,7 @@ func (d *Data) FillDefault() {
 	d.PVP = true
 	d.Platform = 2
 	d.PlatformBroadcastIntent = 3
+	d.PlayersSleepingPercentage = 100
 	d.RainLevel = 1.0
 	d.RandomSeed = time.Now().Unix()
 	d.RandomTickSpeed = 1
@@ -221,7 +222,7 @@ func (d *Data) Settings() *world.Settings {
 	d.WorldStartCount += 1
 	difficulty, _ := world.DifficultyByID(int(d.Difficulty))
 	mode, _ := world.GameModeByID(int(d.GameType))
-	return &world.Settings{
+	s := &world.Settings{
 		Name:            d.LevelName,
 		Spawn:           cube.Pos{int(d.SpawnX), int(d.SpawnY), int(d.SpawnZ)},
 		Time:            d.Time,
@@ -235,7 +236,15 @@ func (d *Data) Settings() *world.Settings {
 		DefaultGameMode: mode,
 		Difficulty:      difficulty,
 		TickRange:       d.ServerChunkTickRange,
+
+		PlayersSleepingPercentage: d.PlayersSleepingPercentage,
+	}
+	if s.PlayersSleepingPercentage == 0 {
+		// Worlds created before the percentage was saved have no value set,
+		// in which case all players must sleep to skip the night.
+		s.PlayersSleepingPercentage = 100
 	}
+	return s
 }
 
 // PutSettings updates d with the Settings stored in s.
@@ -256,6 +265,7 @@ func (d *Data) PutSettings(s *world.Settings) {
 	}
 	d.CurrentTick = s.CurrentTick
 	d.ServerChunkTickRange = s.TickRange
+	d.PlayersSleepingPercentage = s.PlayersSleepingPercentage
 	mode, _ := world.GameModeID(s.DefaultGameMode)
 	d.GameType = int32(mode)
 	difficulty, _ := world.DifficultyID(s.Difficulty)
@@ -81,6 +81,15 @@ type LavaDrip struct{ particle }
 // Lava is a particle that shows up randomly above lava.
 type Lava struct{ particle }
 
+// CampfireSmoke is a particle shown above lit campfires. If Tall is true, the smoke rises higher, as it does above
+// campfires placed on hay bales.
+type CampfireSmoke struct {
+	particle
+
+	// Tall specifies if the smoke should rise higher than usual.
+	Tall bool
+}
+
 // particle serves as a base for all particles in this package.
 type particle struct{}
 
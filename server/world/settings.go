@@ -41,6 +41,9 @@ type Settings struct {
 	// TickRange is the radius in chunks around a Viewer that has its blocks and entities ticked when the world is
 	// ticked. If set to 0, blocks and entities will never be ticked.
 	TickRange int32
+	// PlayersSleepingPercentage is the percentage of players in the World that must be sleeping for the night to be
+	// skipped. If set to 0, a single sleeping player is enough.
+	PlayersSleepingPercentage int32
 }
 
 // defaultSettings returns the default Settings for a new World.
@@ -52,5 +55,7 @@ func defaultSettings() *Settings {
 		TimeCycle:       true,
 		WeatherCycle:    true,
 		TickRange:       6,
+
+		PlayersSleepingPercentage: 100,
 	}
 }
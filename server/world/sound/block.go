@@ -57,6 +57,12 @@ type BarrelOpen struct{ sound }
 // BarrelClose is played when a barrel is closed.
 type BarrelClose struct{ sound }
 
+// ShulkerBoxOpen is played when a shulker box is opened.
+type ShulkerBoxOpen struct{ sound }
+
+// ShulkerBoxClose is played when a shulker box is closed.
+type ShulkerBoxClose struct{ sound }
+
 // Deny is a sound played when a block is placed or broken above a 'Deny' block from Education edition.
 type Deny struct{ sound }
 
@@ -120,6 +126,12 @@ type DoorCrash struct{ sound }
 // Click is a clicking sound.
 type Click struct{ sound }
 
+// ClickFail is a clicking sound played when a block, such as a dispenser, fails to perform an action.
+type ClickFail struct{ sound }
+
+// Launch is a sound played when a projectile is shot out of a dispenser.
+type Launch struct{ sound }
+
 // Ignite is a sound played when using a flint & steel.
 type Ignite struct{ sound }
 
@@ -129,6 +141,9 @@ type TNT struct{ sound }
 // FireExtinguish is a sound played when a fire is extinguished.
 type FireExtinguish struct{ sound }
 
+// CampfireCrackle is a sound played every so often from a lit campfire.
+type CampfireCrackle struct{ sound }
+
 // Note is a sound played by note blocks.
 type Note struct {
 	sound
@@ -167,6 +182,9 @@ type BlastFurnaceCrackle struct{ sound }
 // SmokerCrackle is a sound played every one to five seconds from a smoker.
 type SmokerCrackle struct{ sound }
 
+// PotionBrewed is a sound played when a brewing stand finishes brewing potions.
+type PotionBrewed struct{ sound }
+
 // ComposterEmpty is a sound played when a composter has been emptied.
 type ComposterEmpty struct{ sound }
 
@@ -188,6 +206,42 @@ type SignWaxed struct{ sound }
 // WaxedSignFailedInteraction is a sound played when a player tries to interact with a waxed sign.
 type WaxedSignFailedInteraction struct{ sound }
 
+// CauldronFillWater is a sound played when water is added to a cauldron.
+type CauldronFillWater struct{ sound }
+
+// CauldronTakeWater is a sound played when water is taken from a cauldron.
+type CauldronTakeWater struct{ sound }
+
+// CauldronFillLava is a sound played when lava is added to a cauldron.
+type CauldronFillLava struct{ sound }
+
+// CauldronTakeLava is a sound played when lava is taken from a cauldron.
+type CauldronTakeLava struct{ sound }
+
+// CauldronFillPowderSnow is a sound played when powder snow is added to a cauldron.
+type CauldronFillPowderSnow struct{ sound }
+
+// CauldronTakePowderSnow is a sound played when powder snow is taken from a cauldron.
+type CauldronTakePowderSnow struct{ sound }
+
+// CauldronFillPotion is a sound played when a potion is added to a cauldron.
+type CauldronFillPotion struct{ sound }
+
+// CauldronTakePotion is a sound played when a potion is taken from a cauldron.
+type CauldronTakePotion struct{ sound }
+
+// CauldronAddDye is a sound played when a dye is added to the water in a cauldron.
+type CauldronAddDye struct{ sound }
+
+// CauldronDyeArmour is a sound played when leather armour is dyed using the dyed water in a cauldron.
+type CauldronDyeArmour struct{ sound }
+
+// CauldronCleanArmour is a sound played when the dye is washed off leather armour in a cauldron.
+type CauldronCleanArmour struct{ sound }
+
+// CauldronCleanBanner is a sound played when a pattern is washed off a banner in a cauldron.
+type CauldronCleanBanner struct{ sound }
+
 // sound implements the world.Sound interface.
 type sound struct{}
 
@@ -1,5 +1,7 @@
 package sound
 
+import "github.com/df-mc/dragonfly/server/world"
+
 // Attack is a sound played when an entity, most notably a player, attacks another entity.
 type Attack struct {
 	// Damage specifies if the attack actually dealt damage to the other entity. If set to false, the sound
@@ -58,3 +60,20 @@ type FireworkBlast struct{ sound }
 
 // FireworkTwinkle is a sound played when a firework explodes and should twinkle.
 type FireworkTwinkle struct{ sound }
+
+// MilkCow is a sound played when a cow is milked using a bucket.
+type MilkCow struct{ sound }
+
+// Shear is a sound played when a sheep is sheared.
+type Shear struct{ sound }
+
+// Plop is a sound played when a chicken lays an egg.
+type Plop struct{ sound }
+
+// Flop is a sound played when a fish flops around on land.
+type Flop struct {
+	// Entity is the type of the fish that flops.
+	Entity world.EntityType
+
+	sound
+}
@@ -44,6 +44,15 @@ type BucketEmpty struct {
 	sound
 }
 
+// BucketFillFish is a sound played when a fish is captured using a bucket of water.
+type BucketFillFish struct{ sound }
+
+// BucketEmptyFish is a sound played when a bucket holding a fish is emptied into the world.
+type BucketEmptyFish struct{ sound }
+
+// ShieldBlock is a sound played when a shield blocks an attack or a projectile.
+type ShieldBlock struct{ sound }
+
 // BowShoot is a sound played when a bow is shot.
 type BowShoot struct{ sound }
 
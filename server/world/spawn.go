@@ -0,0 +1,333 @@
+package world
+
+import (
+	"math"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// MobCategory is a category of mobs that are spawned naturally. Every
+// category has its own mob cap and its own rules for where mobs may spawn.
+type MobCategory uint8
+
+const (
+	// MobCategoryMisc is the category of mobs that are never spawned naturally
+	// and that do not count towards any mob cap, such as villagers.
+	MobCategoryMisc MobCategory = iota
+	// MobCategoryMonster is the category of hostile mobs, such as zombies.
+	// Monsters spawn in the dark and are not spawned on peaceful difficulty.
+	MobCategoryMonster
+	// MobCategoryCreature is the category of passive animals, such as cows.
+	// Creatures spawn in light areas.
+	MobCategoryCreature
+	// MobCategoryAmbient is the category of ambient mobs, such as bats.
+	MobCategoryAmbient
+	// MobCategoryWater is the category of mobs living in water, such as squid.
+	// Water mobs spawn in water.
+	MobCategoryWater
+)
+
+// Cap returns the maximum number of mobs of the MobCategory that may be in a
+// world with one loader at once. The cap is scaled by the number of chunks
+// around loaders.
+func (c MobCategory) Cap() int {
+	switch c {
+	case MobCategoryMonster:
+		return 70
+	case MobCategoryCreature:
+		return 10
+	case MobCategoryAmbient:
+		return 15
+	case MobCategoryWater:
+		return 5
+	}
+	return 0
+}
+
+// SpawnEntry is an entry in the spawn list of a Biome. It specifies a mob that
+// may be spawned naturally in the Biome.
+type SpawnEntry struct {
+	// Category is the MobCategory of the mob spawned.
+	Category MobCategory
+	// Weight is the weight of the entry. Entries with a higher weight are
+	// picked more often than entries with a lower weight.
+	Weight int
+	// MinGroup and MaxGroup are the minimum and maximum number of mobs spawned
+	// together in a group.
+	MinGroup, MaxGroup int
+	// New creates the mob spawned at the position passed.
+	New func(pos mgl64.Vec3) Entity
+	// Condition is an optional function that must return true for the mob to
+	// be spawned at the position passed, in addition to the rules of the
+	// Category.
+	Condition func(w *World, pos cube.Pos) bool
+}
+
+// NaturalEntity is an Entity that belongs to a MobCategory. NaturalEntities
+// count towards the mob cap of their category and are despawned when they are
+// far away from loaders, unless they are persistent.
+type NaturalEntity interface {
+	Entity
+	// MobCategory returns the MobCategory of the entity.
+	MobCategory() MobCategory
+	// Persistent checks if the entity should never be despawned.
+	Persistent() bool
+}
+
+const (
+	// spawnChunkRadius is the radius in chunks around loaders in which mobs
+	// are spawned.
+	spawnChunkRadius = 8
+	// minSpawnDistance is the minimum distance from loaders at which mobs are
+	// spawned.
+	minSpawnDistance = 24
+	// despawnDistance is the distance from the nearest loader at which mobs
+	// are despawned immediately.
+	despawnDistance = 128
+	// randomDespawnDistance is the distance from the nearest loader beyond
+	// which mobs have a chance of being despawned every tick.
+	randomDespawnDistance = 32
+)
+
+// tickSpawning spawns mobs naturally around the loaders passed, as long as the
+// mob caps of their categories are not yet reached, and despawns mobs that are
+// too far away from the loaders.
+func (t ticker) tickSpawning(loaders []*Loader, tick int64) {
+	if t.w.conf.Spawns == nil || len(loaders) == 0 {
+		return
+	}
+	positions := make([]mgl64.Vec3, 0, len(loaders))
+	for _, l := range loaders {
+		l.mu.RLock()
+		positions = append(positions, l.position)
+		l.mu.RUnlock()
+	}
+	counts := t.despawnMobs(positions)
+
+	chunks := t.spawnChunks(loaders)
+	for c := MobCategoryMonster; c <= MobCategoryWater; c++ {
+		if c == MobCategoryMonster && t.w.Difficulty() == DifficultyPeaceful {
+			continue
+		}
+		if c == MobCategoryCreature && tick%400 != 0 {
+			// Creatures are spawned much less frequently than other mobs.
+			continue
+		}
+		limit := c.Cap() * len(chunks) / 289
+		for _, pos := range chunks {
+			if counts[c] >= limit {
+				break
+			}
+			counts[c] += t.spawnGroup(c, pos, positions)
+		}
+	}
+}
+
+// despawnMobs removes NaturalEntities that are not persistent and are too far
+// away from the positions passed. The number of remaining NaturalEntities in
+// every MobCategory is returned.
+func (t ticker) despawnMobs(positions []mgl64.Vec3) map[MobCategory]int {
+	counts := make(map[MobCategory]int, 4)
+	for _, e := range t.w.Entities() {
+		n, ok := e.(NaturalEntity)
+		if !ok || n.MobCategory() == MobCategoryMisc {
+			continue
+		}
+		if !n.Persistent() {
+			dist := nearestDistance(n.Position(), positions)
+			if dist > despawnDistance || (dist > randomDespawnDistance && t.w.r.Intn(800) == 0) {
+				_ = n.Close()
+				continue
+			}
+		}
+		counts[n.MobCategory()]++
+	}
+	return counts
+}
+
+// spawnChunks returns the positions of all loaded chunks within the spawn
+// radius of the loaders passed.
+func (t ticker) spawnChunks(loaders []*Loader) []ChunkPos {
+	r := min(int32(t.w.tickRange()), spawnChunkRadius)
+	loaded := make([]ChunkPos, 0, len(loaders))
+	for _, loader := range loaders {
+		loader.mu.RLock()
+		loaded = append(loaded, loader.pos)
+		loader.mu.RUnlock()
+	}
+	t.w.chunkMu.Lock()
+	defer t.w.chunkMu.Unlock()
+
+	chunks := make([]ChunkPos, 0, len(t.w.chunks))
+	for pos := range t.w.chunks {
+		if t.anyWithinDistance(pos, loaded, r) {
+			chunks = append(chunks, pos)
+		}
+	}
+	return chunks
+}
+
+// spawnGroup attempts to spawn a group of mobs of the MobCategory passed at a
+// random position in the chunk passed. The number of mobs spawned is returned.
+func (t ticker) spawnGroup(c MobCategory, chunk ChunkPos, loaders []mgl64.Vec3) int {
+	w, r := t.w, t.w.r
+	x, z := int(chunk[0]<<4)+r.Intn(16), int(chunk[1]<<4)+r.Intn(16)
+	top := w.HighestBlock(x, z) + 1
+	if top <= w.Range()[0] {
+		return 0
+	}
+	pos := cube.Pos{x, w.Range()[0] + r.Intn(top-w.Range()[0]+1), z}
+
+	entry, ok := w.randomSpawnEntry(c, w.Biome(pos))
+	if !ok {
+		return 0
+	}
+	size := entry.MinGroup
+	if entry.MaxGroup > entry.MinGroup {
+		size += r.Intn(entry.MaxGroup - entry.MinGroup + 1)
+	}
+	spawned := 0
+	for i := 0; i < size*2 && spawned < size; i++ {
+		pos = pos.Add(cube.Pos{r.Intn(6) - r.Intn(6), 0, r.Intn(6) - r.Intn(6)})
+		if pos.OutOfBounds(w.Range()) {
+			continue
+		}
+		vec := pos.Vec3Middle()
+		if nearestDistance(vec, loaders) < minSpawnDistance || !w.canSpawn(c, pos) {
+			continue
+		}
+		if entry.Condition != nil && !entry.Condition(w, pos) {
+			continue
+		}
+		e := entry.New(vec)
+		if !w.spaceFor(e) {
+			continue
+		}
+		ctx := event.C()
+		if w.Handler().HandleMobSpawn(ctx, &e); ctx.Cancelled() || e == nil {
+			continue
+		}
+		w.AddEntity(e)
+		spawned++
+	}
+	return spawned
+}
+
+// randomSpawnEntry picks a random SpawnEntry of the MobCategory passed from
+// the spawn list of the Biome passed, taking the weight of entries into
+// account. False is returned if the Biome has no entries of the MobCategory.
+func (w *World) randomSpawnEntry(c MobCategory, b Biome) (SpawnEntry, bool) {
+	var (
+		entries []SpawnEntry
+		total   int
+	)
+	for _, entry := range w.conf.Spawns(b) {
+		if entry.Category == c && entry.Weight > 0 && entry.New != nil {
+			entries = append(entries, entry)
+			total += entry.Weight
+		}
+	}
+	if total == 0 {
+		return SpawnEntry{}, false
+	}
+	n := w.r.Intn(total)
+	for _, entry := range entries {
+		if n -= entry.Weight; n < 0 {
+			return entry, true
+		}
+	}
+	return SpawnEntry{}, false
+}
+
+// canSpawn checks if a mob of the MobCategory passed may spawn at the position
+// passed, based on the blocks around the position and the light level.
+func (w *World) canSpawn(c MobCategory, pos cube.Pos) bool {
+	if c == MobCategoryWater {
+		return w.water(pos) && w.water(pos.Side(cube.FaceUp))
+	}
+	below := pos.Side(cube.FaceDown)
+	if !w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) || w.water(pos) {
+		return false
+	}
+	switch c {
+	case MobCategoryMonster:
+		return w.blockLight(pos) == 0 && w.skyLightAtTime(pos) <= 7
+	case MobCategoryCreature:
+		return max(int(w.blockLight(pos)), w.skyLightAtTime(pos)) >= 9
+	}
+	return true
+}
+
+// spaceFor checks if the Entity passed fits at its position without colliding
+// with any blocks or being inside a liquid.
+func (w *World) spaceFor(e Entity) bool {
+	box := e.Type().BBox(e).Translate(e.Position())
+	n, ok := e.(NaturalEntity)
+	aquatic := ok && n.MobCategory() == MobCategoryWater
+
+	lo, hi := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+	for x := lo[0]; x <= hi[0]; x++ {
+		for y := lo[1]; y <= hi[1]; y++ {
+			for z := lo[2]; z <= hi[2]; z++ {
+				pos := cube.Pos{x, y, z}
+				if _, ok := w.Liquid(pos); ok && !aquatic {
+					return false
+				}
+				for _, bb := range w.Block(pos).Model().BBox(pos, w) {
+					if bb.Translate(pos.Vec3()).IntersectsWith(box) {
+						return false
+					}
+				}
+			}
+		}
+	}
+	return true
+}
+
+// water checks if the block at the position passed is water.
+func (w *World) water(pos cube.Pos) bool {
+	liq, ok := w.Liquid(pos)
+	return ok && liq.LiquidType() == "water"
+}
+
+// blockLight returns the light level at the position passed emitted by blocks,
+// such as torches.
+func (w *World) blockLight(pos cube.Pos) uint8 {
+	if pos.OutOfBounds(w.Range()) {
+		return 0
+	}
+	c := w.chunk(chunkPosFromBlockPos(pos))
+	defer c.Unlock()
+	return c.SubChunk(int16(pos[1])).BlockLight(uint8(pos[0]&15), uint8(pos[1]&15), uint8(pos[2]&15))
+}
+
+// skyLightAtTime returns the skylight level at the position passed, reduced
+// based on the time of the world. At night, the skylight is reduced by 11.
+func (w *World) skyLightAtTime(pos cube.Pos) int {
+	sky := int(w.SkyLight(pos))
+	if !w.conf.Dim.TimeCycle() {
+		return sky
+	}
+	var darkening int
+	switch t := w.Time() % 24000; {
+	case t >= 13000 && t < 23000:
+		darkening = 11
+	case t >= 12000 && t < 13000:
+		darkening = int(float64(t-12000) / 1000 * 11)
+	case t >= 23000:
+		darkening = int(float64(24000-t) / 1000 * 11)
+	}
+	return max(sky-darkening, 0)
+}
+
+// nearestDistance returns the distance between the position passed and the
+// nearest of the positions in the slice passed.
+func nearestDistance(pos mgl64.Vec3, positions []mgl64.Vec3) float64 {
+	dist := math.MaxFloat64
+	for _, p := range positions {
+		dist = min(dist, p.Sub(pos).Len())
+	}
+	return dist
+}
@@ -69,6 +69,7 @@ func (t ticker) tick() {
 	}
 
 	t.tickEntities(tick)
+	t.tickSpawning(loaders, tick)
 	t.tickBlocksRandomly(loaders, tick)
 	t.tickScheduledBlocks(tick)
 	t.performNeighbourUpdates()
@@ -932,6 +932,28 @@ func (w *World) SetDifficulty(d Difficulty) {
 	w.set.Difficulty = d
 }
 
+// PlayersSleepingPercentage returns the percentage of players in the world that must be sleeping for the night
+// to be skipped.
+func (w *World) PlayersSleepingPercentage() int {
+	if w == nil {
+		return 100
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return int(w.set.PlayersSleepingPercentage)
+}
+
+// SetPlayersSleepingPercentage changes the percentage of players in the world that must be sleeping for the night
+// to be skipped. The percentage is clamped between 0 and 100.
+func (w *World) SetPlayersSleepingPercentage(percentage int) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.PlayersSleepingPercentage = int32(min(max(percentage, 0), 100))
+}
+
 // ScheduleBlockUpdate schedules a block update at the position passed after a specific delay. If the block at
 // that position does not handle block updates, nothing will happen.
 func (w *World) ScheduleBlockUpdate(pos cube.Pos, delay time.Duration) {